@@ -0,0 +1,90 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	"github.com/iancoleman/strcase"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/presslabs/controller-util/pkg/syncer"
+)
+
+// SetCondition adds the condition or updates the existing condition of the
+// same type. The LastTransitionTime is changed only when the status changes
+// and defaults to now. It returns true if the conditions changed.
+func SetCondition(conditions *[]metav1.Condition, condition metav1.Condition) bool {
+	return apimeta.SetStatusCondition(conditions, condition)
+}
+
+// RemoveCondition removes the condition of the given type. It returns true if
+// the condition was found.
+func RemoveCondition(conditions *[]metav1.Condition, conditionType string) bool {
+	return apimeta.RemoveStatusCondition(conditions, conditionType)
+}
+
+// FindCondition returns the condition of the given type or nil if not found.
+func FindCondition(conditions []metav1.Condition, conditionType string) *metav1.Condition {
+	return apimeta.FindStatusCondition(conditions, conditionType)
+}
+
+// IsTrue returns true if the condition of the given type has status True.
+func IsTrue(conditions []metav1.Condition, conditionType string) bool {
+	return apimeta.IsStatusConditionTrue(conditions, conditionType)
+}
+
+// SyncConditionType returns the type of the condition which reflects the
+// outcome of the syncer with the given name. (eg. MysqlStatefulSetReady).
+func SyncConditionType(syncerName string) string {
+	return strcase.ToCamel(syncerName) + "Ready"
+}
+
+// FromSyncResult returns the condition which reflects the outcome of the
+// syncer with the given name. The condition is True if the sync succeeded and
+// takes its reason and message from the sync event.
+func FromSyncResult(syncerName string, result syncer.SyncResult, err error) metav1.Condition {
+	condition := metav1.Condition{
+		Type:    SyncConditionType(syncerName),
+		Status:  metav1.ConditionTrue,
+		Reason:  result.EventReason,
+		Message: result.EventMessage,
+	}
+
+	if err != nil {
+		condition.Status = metav1.ConditionFalse
+
+		if condition.Message == "" {
+			condition.Message = err.Error()
+		}
+	}
+
+	if condition.Reason == "" {
+		condition.Reason = syncer.EventReason(syncerName, err)
+	}
+
+	return condition
+}
+
+// SetSyncCondition sets the condition which reflects the outcome of the
+// syncer with the given name. The generation is the one of the object the
+// conditions belong to. It returns true if the conditions changed.
+func SetSyncCondition(conditions *[]metav1.Condition, generation int64, syncerName string, result syncer.SyncResult, err error) bool {
+	condition := FromSyncResult(syncerName, result, err)
+	condition.ObservedGeneration = generation
+
+	return SetCondition(conditions, condition)
+}
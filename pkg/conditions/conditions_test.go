@@ -0,0 +1,106 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/presslabs/controller-util/pkg/syncer"
+)
+
+var _ = Describe("Conditions", func() {
+	var conditions []metav1.Condition
+
+	BeforeEach(func() {
+		conditions = nil
+	})
+
+	It("keeps the last transition time while the status doesn't change", func() {
+		past := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+
+		Expect(SetCondition(&conditions, metav1.Condition{
+			Type: "Ready", Status: metav1.ConditionTrue, Reason: "Available", LastTransitionTime: past,
+		})).To(BeTrue())
+		Expect(SetCondition(&conditions, metav1.Condition{
+			Type: "Ready", Status: metav1.ConditionTrue, Reason: "StillAvailable",
+		})).To(BeTrue())
+
+		condition := FindCondition(conditions, "Ready")
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).To(Equal("StillAvailable"))
+		Expect(condition.LastTransitionTime).To(Equal(past))
+		Expect(IsTrue(conditions, "Ready")).To(BeTrue())
+
+		Expect(SetCondition(&conditions, metav1.Condition{
+			Type: "Ready", Status: metav1.ConditionFalse, Reason: "Unavailable",
+		})).To(BeTrue())
+		Expect(FindCondition(conditions, "Ready").LastTransitionTime).NotTo(Equal(past))
+		Expect(IsTrue(conditions, "Ready")).To(BeFalse())
+	})
+
+	It("removes conditions", func() {
+		SetCondition(&conditions, metav1.Condition{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Available"})
+
+		Expect(RemoveCondition(&conditions, "Ready")).To(BeTrue())
+		Expect(RemoveCondition(&conditions, "Ready")).To(BeFalse())
+		Expect(FindCondition(conditions, "Ready")).To(BeNil())
+	})
+
+	DescribeTable("at FromSyncResult function call", func(result syncer.SyncResult, err error, expected metav1.Condition) {
+		Expect(FromSyncResult("mysql-stateful-set", result, err)).To(Equal(expected))
+	},
+		Entry("successful sync",
+			syncer.SyncResult{
+				Operation:    controllerutil.OperationResultUpdated,
+				EventType:    "Normal",
+				EventReason:  "MysqlStatefulSetSyncSuccessfull",
+				EventMessage: "StatefulSet default/mysql updated successfully",
+			}, nil,
+			metav1.Condition{
+				Type:    "MysqlStatefulSetReady",
+				Status:  metav1.ConditionTrue,
+				Reason:  "MysqlStatefulSetSyncSuccessfull",
+				Message: "StatefulSet default/mysql updated successfully",
+			},
+		),
+		Entry("failed sync without event",
+			syncer.SyncResult{}, errors.New("connection refused"), //nolint: err113
+			metav1.Condition{
+				Type:    "MysqlStatefulSetReady",
+				Status:  metav1.ConditionFalse,
+				Reason:  "MysqlStatefulSetSyncFailed",
+				Message: "connection refused",
+			},
+		),
+	)
+
+	It("sets the sync condition with the observed generation", func() {
+		Expect(SetSyncCondition(&conditions, 3, "MysqlStatefulSet", syncer.SyncResult{}, nil)).To(BeTrue())
+
+		condition := FindCondition(conditions, "MysqlStatefulSetReady")
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.ObservedGeneration).To(Equal(int64(3)))
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+	})
+})
@@ -0,0 +1,211 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package confighash provides functions for hashing the ConfigMaps and the
+// Secrets consumed by a pod template, so workloads are rolled out when their
+// configuration changes.
+package confighash
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultAnnotation is the pod template annotation holding the config hash.
+const DefaultAnnotation = "controller-util.presslabs.com/config-hash"
+
+const (
+	configMapKind = "ConfigMap"
+	secretKind    = "Secret"
+)
+
+// reference is a ConfigMap or a Secret referenced by a pod spec.
+type reference struct {
+	kind string
+	name string
+}
+
+// SetAnnotation sets the DefaultAnnotation on the pod template to the hash of
+// the ConfigMaps and Secrets it references from the given namespace. It is
+// meant to be called from an ObjectSyncer SyncFn, so the workload is rolled
+// out when the configuration changes. eg.
+//
+//	confighash.SetAnnotation(ctx, c, sts.Namespace, &sts.Spec.Template)
+func SetAnnotation(ctx context.Context, c client.Reader, namespace string, template *corev1.PodTemplateSpec) error {
+	return SetAnnotationWithKey(ctx, c, namespace, template, DefaultAnnotation)
+}
+
+// SetAnnotationWithKey is just like SetAnnotation, but sets the given annotation.
+func SetAnnotationWithKey(
+	ctx context.Context, c client.Reader, namespace string, template *corev1.PodTemplateSpec, annotation string,
+) error {
+	hash, err := Compute(ctx, c, namespace, &template.Spec)
+	if err != nil {
+		return err
+	}
+
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+
+	template.Annotations[annotation] = hash
+
+	return nil
+}
+
+// Compute returns a stable hash of the content of the ConfigMaps and the
+// Secrets referenced by the pod spec volumes, envFrom and env valueFrom, which
+// are read from the given namespace. The missing objects are hashed as
+// absent, so the hash changes when they are created.
+func Compute(ctx context.Context, c client.Reader, namespace string, spec *corev1.PodSpec) (string, error) {
+	content := map[string]interface{}{}
+
+	for _, ref := range references(spec) {
+		data, err := fetch(ctx, c, namespace, ref)
+		if err != nil {
+			return "", err
+		}
+
+		content[ref.kind+"/"+ref.name] = data
+	}
+
+	// json.Marshal sorts the map keys, making the hash stable
+	encoded, err := json.Marshal(content)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(encoded)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// fetch returns the content of the referenced object or nil if it doesn't exist.
+func fetch(ctx context.Context, c client.Reader, namespace string, ref reference) (interface{}, error) {
+	key := client.ObjectKey{Name: ref.name, Namespace: namespace}
+
+	var (
+		obj  client.Object
+		data func() interface{}
+	)
+
+	switch ref.kind {
+	case configMapKind:
+		cm := &corev1.ConfigMap{}
+		obj, data = cm, func() interface{} {
+			return []interface{}{cm.Data, cm.BinaryData}
+		}
+	default:
+		secret := &corev1.Secret{}
+		obj, data = secret, func() interface{} {
+			return []interface{}{secret.Type, secret.Data}
+		}
+	}
+
+	if err := c.Get(ctx, key, obj); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil //nolint: nilnil
+		}
+
+		return nil, fmt.Errorf("error when fetching %s %s: %w", ref.kind, key, err)
+	}
+
+	return data(), nil
+}
+
+// references returns the ConfigMaps and the Secrets referenced by the pod
+// spec, sorted and without duplicates.
+func references(spec *corev1.PodSpec) []reference {
+	refs := map[reference]struct{}{}
+	add := func(kind, name string) {
+		if name != "" {
+			refs[reference{kind: kind, name: name}] = struct{}{}
+		}
+	}
+
+	for _, volume := range spec.Volumes {
+		if volume.ConfigMap != nil {
+			add(configMapKind, volume.ConfigMap.Name)
+		}
+
+		if volume.Secret != nil {
+			add(secretKind, volume.Secret.SecretName)
+		}
+
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil {
+					add(configMapKind, source.ConfigMap.Name)
+				}
+
+				if source.Secret != nil {
+					add(secretKind, source.Secret.Name)
+				}
+			}
+		}
+	}
+
+	containers := append(append([]corev1.Container{}, spec.InitContainers...), spec.Containers...)
+
+	for _, container := range containers {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.ConfigMapRef != nil {
+				add(configMapKind, envFrom.ConfigMapRef.Name)
+			}
+
+			if envFrom.SecretRef != nil {
+				add(secretKind, envFrom.SecretRef.Name)
+			}
+		}
+
+		for _, env := range container.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+
+			if env.ValueFrom.ConfigMapKeyRef != nil {
+				add(configMapKind, env.ValueFrom.ConfigMapKeyRef.Name)
+			}
+
+			if env.ValueFrom.SecretKeyRef != nil {
+				add(secretKind, env.ValueFrom.SecretKeyRef.Name)
+			}
+		}
+	}
+
+	sorted := make([]reference, 0, len(refs))
+	for ref := range refs {
+		sorted = append(sorted, ref)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].kind != sorted[j].kind {
+			return sorted[i].kind < sorted[j].kind
+		}
+
+		return sorted[i].name < sorted[j].name
+	})
+
+	return sorted
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package confighash
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("ConfigHash", func() {
+	var (
+		c        client.Client
+		config   *corev1.ConfigMap
+		secret   *corev1.Secret
+		template *corev1.PodTemplateSpec
+	)
+
+	hash := func() string {
+		Expect(SetAnnotation(context.TODO(), c, "default", template)).To(Succeed())
+
+		return template.Annotations[DefaultAnnotation]
+	}
+
+	BeforeEach(func() {
+		config = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "default"},
+			Data:       map[string]string{"key": "value"},
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: "default"},
+			Data:       map[string][]byte{"password": []byte("secret")},
+		}
+		unrelated := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "default"},
+		}
+		c = fake.NewClientBuilder().WithObjects(config, secret, unrelated).Build()
+
+		template = &corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{"existing": "annotation"},
+			},
+			Spec: corev1.PodSpec{
+				Volumes: []corev1.Volume{{
+					Name: "config",
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "config"},
+						},
+					},
+				}},
+				Containers: []corev1.Container{{
+					Name: "app",
+					Env: []corev1.EnvVar{{
+						Name: "PASSWORD",
+						ValueFrom: &corev1.EnvVarSource{
+							SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+								Key:                  "password",
+							},
+						},
+					}},
+					EnvFrom: []corev1.EnvFromSource{{
+						ConfigMapRef: &corev1.ConfigMapEnvSource{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "optional"},
+							Optional:             ptr.To(true),
+						},
+					}},
+				}},
+			},
+		}
+	})
+
+	It("finds the referenced objects", func() {
+		Expect(references(&template.Spec)).To(Equal([]reference{
+			{kind: configMapKind, name: "config"},
+			{kind: configMapKind, name: "optional"},
+			{kind: secretKind, name: "secret"},
+		}))
+	})
+
+	It("sets a stable hash on the pod template", func() {
+		first := hash()
+		Expect(first).To(HaveLen(64))
+		Expect(template.Annotations).To(HaveKeyWithValue("existing", "annotation"))
+		Expect(hash()).To(Equal(first))
+	})
+
+	It("changes the hash when the referenced objects change", func() {
+		first := hash()
+
+		secret.Data["password"] = []byte("changed")
+		Expect(c.Update(context.TODO(), secret)).To(Succeed())
+		second := hash()
+		Expect(second).NotTo(Equal(first))
+
+		Expect(c.Create(context.TODO(), &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "optional", Namespace: "default"},
+		})).To(Succeed())
+		Expect(hash()).NotTo(Equal(second))
+	})
+
+	It("does not change the hash when unrelated objects change", func() {
+		first := hash()
+
+		Expect(c.Update(context.TODO(), &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "default"},
+			Data:       map[string]string{"key": "changed"},
+		})).To(Succeed())
+		Expect(hash()).To(Equal(first))
+	})
+})
@@ -59,4 +59,26 @@ var _ = Describe("Meta Package Finalizer", func() {
 		Entry("remove from begin", []string{fin, "f1", "f2"}, []string{"f1", "f2"}),
 		Entry("remove from end", []string{"f1", "f2", fin}, []string{"f1", "f2"}),
 	)
+
+	DescribeTable("at AddObjectFinalizer function call", func(existing, expected []string, added bool) {
+		obj := &metav1.ObjectMeta{
+			Finalizers: existing,
+		}
+		Expect(AddObjectFinalizer(obj, fin)).To(Equal(added))
+		Expect(obj.Finalizers).To(Equal(expected))
+	},
+		Entry("add if not present", []string{"f1", "f2"}, []string{"f1", "f2", fin}, true),
+		Entry("no add if present", []string{"f1", fin, "f2"}, []string{"f1", fin, "f2"}, false),
+	)
+
+	DescribeTable("at RemoveObjectFinalizer function call", func(existing, expected []string, removed bool) {
+		obj := &metav1.ObjectMeta{
+			Finalizers: existing,
+		}
+		Expect(RemoveObjectFinalizer(obj, fin)).To(Equal(removed))
+		Expect(obj.Finalizers).To(Equal(expected))
+	},
+		Entry("no remove if not present", []string{"f1", "f2"}, []string{"f1", "f2"}, false),
+		Entry("remove if present", []string{"f1", fin, "f2"}, []string{"f1", "f2"}, true),
+	)
 })
@@ -37,6 +37,35 @@ func RemoveFinalizer(meta *metav1.ObjectMeta, finalizer string) {
 	meta.Finalizers = removeString(meta.Finalizers, finalizer)
 }
 
+// AddObjectFinalizer adds a finalizer to the object. It returns true if the
+// finalizer was added, so the object needs to be updated.
+func AddObjectFinalizer(obj metav1.Object, finalizer string) bool {
+	if HasObjectFinalizer(obj, finalizer) {
+		return false
+	}
+
+	obj.SetFinalizers(append(obj.GetFinalizers(), finalizer))
+
+	return true
+}
+
+// HasObjectFinalizer returns true if the object has the finalizer.
+func HasObjectFinalizer(obj metav1.Object, finalizer string) bool {
+	return containsString(obj.GetFinalizers(), finalizer)
+}
+
+// RemoveObjectFinalizer removes the finalizer from the object. It returns true
+// if the finalizer was removed, so the object needs to be updated.
+func RemoveObjectFinalizer(obj metav1.Object, finalizer string) bool {
+	if !HasObjectFinalizer(obj, finalizer) {
+		return false
+	}
+
+	obj.SetFinalizers(removeString(obj.GetFinalizers(), finalizer))
+
+	return true
+}
+
 // containsString is a helper functions to check string from a slice of strings.
 func containsString(slice []string, s string) bool {
 	for _, item := range slice {
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+	"reflect"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// DefaultFieldManager is the field manager used for server-side apply when
+// the syncer does not specify one.
+const DefaultFieldManager = "controller-util"
+
+// apply persists the subject using server-side apply. The SyncFn is called on
+// the subject as passed to the syncer, so it must set every field the syncer
+// manages, not only the ones which changed.
+func (s *ObjectSyncer) apply(ctx context.Context) (controllerutil.OperationResult, error) {
+	key := client.ObjectKeyFromObject(s.Obj)
+
+	live := deepCopy(s.Obj)
+	exists := true
+
+	if err := retryClient(ctx, s.Client, s.APIReader).Get(ctx, key, live); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return controllerutil.OperationResultNone, err
+		}
+
+		exists = false
+	}
+
+	if exists && isPaused(live) {
+		restore(s.Obj, live)
+		s.previousObject = live
+
+		return OperationResultPaused, nil
+	}
+
+	if exists && s.Owner != nil && !s.Owner.GetDeletionTimestamp().IsZero() {
+		// the apply request would carry no owner reference, which removes the
+		// one owned by the field manager and orphans the object, so leave it
+		// to the garbage collector
+		restore(s.Obj, live)
+		s.previousObject = live
+
+		return controllerutil.OperationResultNone, nil
+	}
+
+	if exists && s.Recreate != nil && !live.GetDeletionTimestamp().IsZero() {
+		restore(s.Obj, live)
+		s.previousObject = live
+
+		return controllerutil.OperationResultNone, errRecreating
+	}
+
+	if exists && observeOnly(live) {
+		return controllerutil.OperationResultNone, errObserveOnly
+	}
+
+	s.previousObject = s.Obj.DeepCopyObject()
+	if exists {
+		s.previousObject = live
+	}
+
+	if err := s.SyncFn(); err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	if err := setOwnerReference(s.Ownership, s.Owner, s.Obj, exists, s.Client.Scheme()); err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	// apply requests must carry the type information and must not carry a
+	// resource version or managed fields
+	gvk, err := apiutil.GVKForObject(s.Obj, s.Client.Scheme())
+	if err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	s.Obj.GetObjectKind().SetGroupVersionKind(gvk)
+	s.Obj.SetResourceVersion("")
+	s.Obj.SetManagedFields(nil)
+
+	opts := []client.PatchOption{client.FieldOwner(s.fieldManager())}
+	if s.ForceOwnership {
+		opts = append(opts, client.ForceOwnership)
+	}
+
+	if err := clientFor(ctx, s.Client).Patch(ctx, s.Obj, client.Apply, opts...); err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	switch {
+	case !exists:
+		return controllerutil.OperationResultCreated, nil
+	case live.GetResourceVersion() != s.Obj.GetResourceVersion():
+		return controllerutil.OperationResultUpdated, nil
+	case IsDryRun(ctx) && changed(live, s.Obj):
+		// dry-run requests don't bump the resource version
+		return controllerutil.OperationResultUpdated, nil
+	default:
+		return controllerutil.OperationResultNone, nil
+	}
+}
+
+// changed returns true when the objects differ, ignoring their type information.
+func changed(live, obj client.Object) bool {
+	before, err := runtime.DefaultUnstructuredConverter.ToUnstructured(live.DeepCopyObject())
+	if err != nil {
+		return true
+	}
+
+	after, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj.DeepCopyObject())
+	if err != nil {
+		return true
+	}
+
+	for _, u := range []map[string]interface{}{before, after} {
+		delete(u, "apiVersion")
+		delete(u, "kind")
+	}
+
+	return !reflect.DeepEqual(before, after)
+}
+
+func (s *ObjectSyncer) fieldManager() string {
+	if s.FieldManager == "" {
+		return DefaultFieldManager
+	}
+
+	return s.FieldManager
+}
+
+// NewServerSideApplySyncer creates a new kubernetes.Object syncer for a given
+// object with an owner and persists data using server-side apply. The
+// fieldManager identifies the syncer as owner of the applied fields and force
+// takes ownership of fields managed by others. The syncFn receives the object
+// as passed in, not the live one, and must set the whole desired state.
+// The name is used for logging and event emitting purposes and should be an
+// valid go identifier in upper camel case. (eg. MysqlStatefulSet).
+func NewServerSideApplySyncer(
+	name string, owner, obj client.Object, c client.Client, fieldManager string, force bool, syncFn controllerutil.MutateFn,
+) Interface {
+	return &ObjectSyncer{
+		Owner:          owner,
+		Obj:            obj,
+		SyncFn:         syncFn,
+		Name:           name,
+		Client:         c,
+		Strategy:       ServerSideApplyStrategy,
+		FieldManager:   fieldManager,
+		ForceOwnership: force,
+	}
+}
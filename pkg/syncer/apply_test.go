@@ -0,0 +1,137 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/presslabs/controller-util/pkg/syncer"
+)
+
+var _ = Describe("ServerSideApplySyncer", func() {
+	var (
+		recorder *record.FakeRecorder
+		owner    *corev1.ConfigMap
+		key      types.NamespacedName
+		value    string
+	)
+
+	newSyncer := func() syncer.Interface {
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name + "-child",
+				Namespace: key.Namespace,
+			},
+		}
+
+		return syncer.NewServerSideApplySyncer("ExampleConfigMap", owner, cm, c, "test-manager", false, func() error {
+			cm.Data = map[string]string{"key": value}
+
+			return nil
+		})
+	}
+
+	BeforeEach(func() {
+		r := rand.Int31() //nolint: gosec
+
+		key = types.NamespacedName{
+			Name:      fmt.Sprintf("example-%d", r),
+			Namespace: fmt.Sprintf("default-%d", r),
+		}
+		value = "initial"
+		recorder = record.NewFakeRecorder(100)
+		owner = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+		}
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: key.Namespace,
+			},
+		}
+		Expect(c.Create(context.TODO(), ns)).To(Succeed())
+		Expect(c.Create(context.TODO(), owner)).To(Succeed())
+	})
+
+	When("syncing", func() {
+		It("creates, updates and leaves unchanged the object", func() {
+			result, err := newSyncer().Sync(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Operation).To(Equal(controllerutil.OperationResultCreated))
+
+			cm := &corev1.ConfigMap{}
+			Expect(c.Get(context.TODO(), types.NamespacedName{Name: key.Name + "-child", Namespace: key.Namespace}, cm)).To(Succeed())
+			Expect(cm.Data).To(HaveKeyWithValue("key", "initial"))
+			Expect(cm.OwnerReferences).To(HaveLen(1))
+			Expect(cm.ManagedFields).To(ContainElement(HaveField("Manager", "test-manager")))
+
+			result, err = newSyncer().Sync(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Operation).To(Equal(controllerutil.OperationResultNone))
+
+			value = "changed"
+			Expect(syncer.Sync(context.TODO(), newSyncer(), recorder)).To(Succeed())
+			Expect(c.Get(context.TODO(), types.NamespacedName{Name: key.Name + "-child", Namespace: key.Namespace}, cm)).To(Succeed())
+			Expect(cm.Data).To(HaveKeyWithValue("key", "changed"))
+
+			var event string
+			Expect(recorder.Events).To(Receive(&event))
+			Expect(event).To(ContainSubstring("ExampleConfigMapSyncSuccessfull"))
+			Expect(event).To(ContainSubstring("updated successfully"))
+		})
+
+		It("does not create the object when the owner is deleted", func() {
+			now := metav1.Now()
+			owner.DeletionTimestamp = &now
+
+			Expect(syncer.Sync(context.TODO(), newSyncer(), recorder)).To(Succeed())
+
+			cm := &corev1.ConfigMap{}
+			Expect(c.Get(context.TODO(), types.NamespacedName{Name: key.Name + "-child", Namespace: key.Namespace}, cm)).NotTo(Succeed())
+		})
+
+		It("keeps the owner reference of an existing object when the owner is deleted", func() {
+			Expect(syncer.Sync(context.TODO(), newSyncer(), recorder)).To(Succeed())
+
+			now := metav1.Now()
+			owner.DeletionTimestamp = &now
+			value = "changed"
+
+			result, err := newSyncer().Sync(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Operation).To(Equal(controllerutil.OperationResultNone))
+
+			cm := &corev1.ConfigMap{}
+			Expect(c.Get(context.TODO(), types.NamespacedName{Name: key.Name + "-child", Namespace: key.Namespace}, cm)).To(Succeed())
+			Expect(cm.OwnerReferences).To(HaveLen(1))
+			Expect(cm.OwnerReferences[0].Name).To(Equal(owner.Name))
+		})
+	})
+})
@@ -0,0 +1,167 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// maxEventChanges is the maximum number of changed paths listed in an event message.
+const maxEventChanges = 10
+
+// FieldChange is a change of a field between the persisted object and the
+// synced one.
+type FieldChange struct {
+	// Path is the path of the changed field, eg. spec.template.spec.containers[0].image.
+	Path string `json:"path"`
+	// Old is the value before the sync, nil if the field was not set.
+	Old interface{} `json:"old,omitempty"`
+	// New is the value after the sync, nil if the field was removed.
+	New interface{} `json:"new,omitempty"`
+}
+
+// ignoredChanges are the paths of bookkeeping fields which change on every write.
+var ignoredChanges = map[string]bool{
+	"metadata.resourceVersion": true,
+	"metadata.generation":      true,
+	"metadata.managedFields":   true,
+}
+
+// fieldChanges returns the changes between two objects, sorted by path.
+func fieldChanges(before, after runtime.Object) []FieldChange {
+	changes := []FieldChange{}
+	compareFields("", toUnstructured(before), toUnstructured(after), &changes)
+
+	return changes
+}
+
+func toUnstructured(obj runtime.Object) map[string]interface{} {
+	if v := reflect.ValueOf(obj); !v.IsValid() || (v.Kind() == reflect.Pointer && v.IsNil()) {
+		return map[string]interface{}{}
+	}
+
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj.DeepCopyObject())
+	if err != nil {
+		return map[string]interface{}{}
+	}
+
+	return u
+}
+
+func compareFields(path string, before, after interface{}, changes *[]FieldChange) {
+	if ignoredChanges[path] {
+		return
+	}
+
+	beforeMap, beforeIsMap := before.(map[string]interface{})
+	afterMap, afterIsMap := after.(map[string]interface{})
+
+	// compare maps key by key even when one of them is not set
+	if (beforeIsMap || before == nil) && (afterIsMap || after == nil) && (beforeIsMap || afterIsMap) {
+		for _, key := range unionKeys(beforeMap, afterMap) {
+			compareFields(fieldPath(path, key), beforeMap[key], afterMap[key], changes)
+		}
+
+		return
+	}
+
+	beforeList, beforeIsList := before.([]interface{})
+	afterList, afterIsList := after.([]interface{})
+
+	if beforeIsList && afterIsList {
+		for i := range max(len(beforeList), len(afterList)) {
+			var b, a interface{}
+
+			if i < len(beforeList) {
+				b = beforeList[i]
+			}
+
+			if i < len(afterList) {
+				a = afterList[i]
+			}
+
+			compareFields(fmt.Sprintf("%s[%d]", path, i), b, a, changes)
+		}
+
+		return
+	}
+
+	if !reflect.DeepEqual(before, after) {
+		*changes = append(*changes, FieldChange{Path: path, Old: before, New: after})
+	}
+}
+
+// fieldPath returns the path of a map key, using the bracket notation for
+// keys which contain dots, like most annotations and labels.
+func fieldPath(parent, key string) string {
+	if strings.ContainsAny(key, "./") {
+		return fmt.Sprintf("%s[%s]", parent, key)
+	}
+
+	if parent == "" {
+		return key
+	}
+
+	return parent + "." + key
+}
+
+func unionKeys(a, b map[string]interface{}) []string {
+	keys := make([]string, 0, len(a)+len(b))
+
+	for key := range a {
+		keys = append(keys, key)
+	}
+
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// changedPaths returns the paths of the changes, omitting the given path.
+func changedPaths(changes []FieldChange, omit string) []string {
+	paths := []string{}
+
+	for _, change := range changes {
+		if change.Path != omit {
+			paths = append(paths, change.Path)
+		}
+	}
+
+	return paths
+}
+
+// describeChanges returns a short human readable list of the changed paths,
+// omitting the given path.
+func describeChanges(changes []FieldChange, omit string) string {
+	paths := changedPaths(changes, omit)
+	if len(paths) > maxEventChanges {
+		return fmt.Sprintf("%s and %d more", strings.Join(paths[:maxEventChanges], ", "), len(paths)-maxEventChanges)
+	}
+
+	return strings.Join(paths, ", ")
+}
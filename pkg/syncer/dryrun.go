@@ -0,0 +1,56 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// OperationResultSkipped is the result of an external syncer in dry-run mode,
+// which cannot tell the operation it would do without doing it (see
+// NewExternalSyncer).
+const OperationResultSkipped controllerutil.OperationResult = "skipped"
+
+type dryRunKey struct{}
+
+// WithDryRun returns a context which puts the syncers in dry-run mode. In
+// dry-run mode kubernetes objects are persisted using server-side dry-run
+// requests, so syncers report the operations they would do without doing
+// them, and no events are recorded.
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+// IsDryRun returns true if the context puts the syncers in dry-run mode.
+func IsDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool)
+
+	return dryRun
+}
+
+// clientFor returns a client which does only server-side dry-run requests
+// when the context is in dry-run mode, and the given client otherwise.
+func clientFor(ctx context.Context, c client.Client) client.Client {
+	if IsDryRun(ctx) {
+		return client.NewDryRunClient(c)
+	}
+
+	return c
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"text/template"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// legacySuccessReason is the suffix of the default success reasons. The typo
+// is kept on purpose, because existing alerts and dashboards key on it.
+const legacySuccessReason = "SyncSuccessfull"
+
+// SucceededReason is the suffix of the success reasons when
+// EventConfig.CorrectReasons is set.
+const SucceededReason = "SyncSucceeded"
+
+// EventConfig overrides the reason and the message of the events recorded for
+// a syncer. The templates are executed with an EventData. A nil template
+// keeps the syncer default.
+//
+// The default reasons are <Name>SyncSuccessfull and <Name>SyncFailed. The
+// misspelled success reason is kept on purpose, as a compatibility mode for
+// the existing alerts and dashboards. Set CorrectReasons to use
+// <Name>SyncSucceeded instead.
+//
+// eg. template.Must(template.New("reason").Parse(`{{ if .Error }}ChildFailed{{ else }}ChildReady{{ end }}`))
+type EventConfig struct {
+	Reason  *template.Template
+	Message *template.Template
+
+	// CorrectReasons replaces the default <Name>SyncSuccessfull reason with
+	// <Name>SyncSucceeded. It's applied before the Reason template.
+	CorrectReasons bool
+}
+
+// EventData is the data passed to the EventConfig templates.
+type EventData struct {
+	// Kind is the kind of the event subject (eg. Deployment).
+	Kind string
+	// Key is the namespace/name key of the subject, when it's a kubernetes object.
+	Key string
+	// Operation is the sync operation.
+	Operation controllerutil.OperationResult
+	// Error is the sync error, if the sync failed.
+	Error error
+	// Reason and Message are the event reason and message set by the syncer.
+	Reason  string
+	Message string
+}
+
+type eventConfigSyncer struct {
+	Interface
+
+	config EventConfig
+}
+
+// WithEventConfig returns a syncer which records the events of the given
+// syncer with the reason and the message rendered using the config. Besides
+// the sync event, the config applies to the per-object events too (eg. the
+// events of the pruned objects), rendered with the related object kind and
+// key.
+func WithEventConfig(syncer Interface, config EventConfig) Interface {
+	return &eventConfigSyncer{
+		Interface: syncer,
+		config:    config,
+	}
+}
+
+func (s *eventConfigSyncer) writesShared() bool {
+	return isSharedWriter(s.Interface)
+}
+
+// Sync runs the syncer and renders its event data.
+func (s *eventConfigSyncer) Sync(ctx context.Context) (SyncResult, error) {
+	result, err := s.Interface.Sync(ctx)
+
+	if result.EventType != "" {
+		reason, message := s.render(ctx, newEventData(s.Object(), result.Operation, err,
+			result.EventReason, result.EventMessage))
+		result.SetEventData(result.EventType, reason, message)
+	}
+
+	if len(result.Events) > 0 {
+		events := make([]Event, 0, len(result.Events))
+
+		for _, event := range result.Events {
+			event.Reason, event.Message = s.render(ctx, newEventData(event.Related, result.Operation, err,
+				event.Reason, event.Message))
+			events = append(events, event)
+		}
+
+		result.Events = events
+	}
+
+	return result, err
+}
+
+// render returns the event reason and message rendered using the config.
+func (s *eventConfigSyncer) render(ctx context.Context, data EventData) (string, string) {
+	log := logf.FromContext(ctx)
+
+	if s.config.CorrectReasons && strings.HasSuffix(data.Reason, legacySuccessReason) {
+		data.Reason = strings.TrimSuffix(data.Reason, legacySuccessReason) + SucceededReason
+	}
+
+	reason, err := render(s.config.Reason, data, data.Reason)
+	if err != nil {
+		log.Error(err, "failed rendering event reason", "kind", data.Kind, "key", data.Key)
+	}
+
+	message, err := render(s.config.Message, data, data.Message)
+	if err != nil {
+		log.Error(err, "failed rendering event message", "kind", data.Kind, "key", data.Key)
+	}
+
+	return reason, message
+}
+
+// newEventData returns the template data for an event about the subject.
+func newEventData(subject interface{}, op controllerutil.OperationResult, err error, reason, message string) EventData {
+	data := EventData{
+		Kind:      subjectKind(subject),
+		Operation: op,
+		Error:     err,
+		Reason:    reason,
+		Message:   message,
+	}
+
+	if obj, ok := subject.(client.Object); ok {
+		data.Key = client.ObjectKeyFromObject(obj).String()
+	}
+
+	return data
+}
+
+// render executes the template with the given data. It returns def when the
+// template is nil or fails.
+func render(tmpl *template.Template, data EventData, def string) (string, error) {
+	if tmpl == nil {
+		return def, nil
+	}
+
+	var out strings.Builder
+	if err := tmpl.Execute(&out, data); err != nil {
+		return def, err
+	}
+
+	return out.String(), nil
+}
+
+// subjectKind returns the kind of a syncer subject, without needing a scheme.
+func subjectKind(obj interface{}) string {
+	if obj, ok := obj.(client.Object); ok {
+		if kind := obj.GetObjectKind().GroupVersionKind().Kind; kind != "" {
+			return kind
+		}
+	}
+
+	t := reflect.TypeOf(obj)
+	if t == nil {
+		return "nil"
+	}
+
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t.Name()
+}
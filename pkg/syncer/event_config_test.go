@@ -0,0 +1,105 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+	"errors"
+	"text/template"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+var _ = Describe("WithEventConfig", func() {
+	var (
+		recorder *record.FakeRecorder
+		owner    *corev1.ConfigMap
+	)
+
+	newSyncer := func(err error) Interface {
+		return NewExternalSyncer("ExampleRepo", owner, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "child", Namespace: "default"},
+		}, func(context.Context, interface{}) (controllerutil.OperationResult, error) {
+			return controllerutil.OperationResultUpdated, err
+		})
+	}
+
+	BeforeEach(func() {
+		recorder = record.NewFakeRecorder(100)
+		owner = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: "default"}}
+	})
+
+	It("renders the event reason and message", func() {
+		config := EventConfig{
+			Reason: template.Must(template.New("reason").Parse(
+				`{{ .Kind }}{{ if .Error }}Failed{{ else }}Ready{{ end }}`)),
+			Message: template.Must(template.New("message").Parse(
+				`{{ .Key }} {{ .Operation }}{{ with .Error }}: {{ . }}{{ end }}`)),
+		}
+
+		Expect(Sync(context.TODO(), WithEventConfig(newSyncer(nil), config), recorder)).To(Succeed())
+		Expect(Sync(context.TODO(), WithEventConfig(newSyncer(errors.New("boom")), config), recorder)).NotTo(Succeed()) //nolint: err113
+
+		Expect(<-recorder.Events).To(Equal("Normal ConfigMapReady default/child updated"))
+		Expect(<-recorder.Events).To(Equal("Warning ConfigMapFailed default/child updated: boom"))
+	})
+
+	It("keeps the default reason when not overridden", func() {
+		config := EventConfig{
+			Message: template.Must(template.New("message").Parse(`{{ .Message }} ({{ .Reason }})`)),
+		}
+
+		Expect(Sync(context.TODO(), WithEventConfig(newSyncer(nil), config), recorder)).To(Succeed())
+
+		Expect(<-recorder.Events).To(Equal(
+			"Normal ExampleRepoSyncSuccessfull *v1.ConfigMap successfully updated (ExampleRepoSyncSuccessfull)"))
+	})
+
+	It("uses the corrected success reason when asked to", func() {
+		Expect(Sync(context.TODO(), WithEventConfig(newSyncer(nil), EventConfig{CorrectReasons: true}), recorder)).To(Succeed())
+		Expect(Sync(context.TODO(), WithEventConfig(newSyncer(errors.New("boom")), EventConfig{CorrectReasons: true}), recorder)).NotTo(Succeed()) //nolint: err113
+
+		Expect(<-recorder.Events).To(Equal("Normal ExampleRepoSyncSucceeded *v1.ConfigMap successfully updated"))
+		Expect(<-recorder.Events).To(HavePrefix("Warning ExampleRepoSyncFailed"))
+	})
+
+	It("renders the per-object events", func() {
+		pruned := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "pruned", Namespace: "default"}}
+		config := EventConfig{
+			CorrectReasons: true,
+			Message:        template.Must(template.New("message").Parse(`{{ .Key }} {{ .Reason }}`)),
+		}
+
+		Expect(Sync(context.TODO(), WithEventConfig(&stubSyncer{owner: owner, result: SyncResult{
+			Operation: OperationResultPruned,
+			Events: []Event{{
+				Type:    eventNormal,
+				Reason:  basicEventReason("ExamplePrune", nil),
+				Message: "ConfigMap default/pruned pruned successfully",
+				Related: pruned,
+			}},
+		}}, config), recorder)).To(Succeed())
+
+		Expect(<-recorder.Events).To(Equal("Normal ExamplePruneSyncSucceeded default/pruned ExamplePruneSyncSucceeded"))
+	})
+})
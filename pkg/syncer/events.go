@@ -0,0 +1,92 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// eventSink records the events of the syncers using either the legacy
+// core/v1 events recorder or the events.k8s.io/v1 one.
+type eventSink interface {
+	event(regarding, related runtime.Object, eventtype, reason, action, message string)
+}
+
+type legacySink struct {
+	recorder record.EventRecorder
+}
+
+func (s legacySink) event(regarding, _ runtime.Object, eventtype, reason, _, message string) {
+	s.recorder.Event(regarding, eventtype, reason, message)
+}
+
+// pauseChanged delegates to the recorder when it's a PolicyRecorder.
+func (s legacySink) pauseChanged(key string, result SyncResult) bool {
+	if recorder, ok := s.recorder.(*PolicyRecorder); ok {
+		return recorder.pauseChanged(key, result)
+	}
+
+	return true
+}
+
+type eventsSink struct {
+	recorder events.EventRecorder
+}
+
+func (s eventsSink) event(regarding, related runtime.Object, eventtype, reason, action, message string) {
+	s.recorder.Eventf(regarding, related, eventtype, reason, action, "%s", message)
+}
+
+func legacyEvents(recorder record.EventRecorder) eventSink {
+	if recorder == nil {
+		return nil
+	}
+
+	return legacySink{recorder: recorder}
+}
+
+func newEvents(recorder events.EventRecorder) eventSink {
+	if recorder == nil {
+		return nil
+	}
+
+	return eventsSink{recorder: recorder}
+}
+
+// SyncWithEvents is just like Sync, but records the events using an
+// events.k8s.io/v1 recorder. The events regard the syncer owner, are related
+// to the syncer subject, when it's a kubernetes object, and their action is
+// the sync operation.
+func SyncWithEvents(ctx context.Context, syncer Interface, recorder events.EventRecorder) error {
+	_, err := syncAndRecord(ctx, syncer, newEvents(recorder))
+
+	return err
+}
+
+// eventAction returns the action of the events recorded for a sync operation.
+func eventAction(op controllerutil.OperationResult) string {
+	if op == "" {
+		return string(controllerutil.OperationResultNone)
+	}
+
+	return string(op)
+}
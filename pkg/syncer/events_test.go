@@ -0,0 +1,81 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+type recordedEventsEvent struct {
+	regarding, related              runtime.Object
+	eventtype, reason, action, note string
+}
+
+type eventsRecorder struct {
+	events []recordedEventsEvent
+}
+
+func (r *eventsRecorder) Eventf(
+	regarding, related runtime.Object, eventtype, reason, action, note string, args ...interface{},
+) {
+	r.events = append(r.events, recordedEventsEvent{regarding, related, eventtype, reason, action, fmt.Sprintf(note, args...)})
+}
+
+func (r *eventsRecorder) WithLogger(klog.Logger) events.EventRecorderLogger {
+	return nil
+}
+
+type stubSyncer struct {
+	owner, obj runtime.Object
+	result     SyncResult
+}
+
+func (s *stubSyncer) Object() interface{}                      { return s.obj }
+func (s *stubSyncer) ObjectOwner() runtime.Object              { return s.owner }
+func (s *stubSyncer) Sync(context.Context) (SyncResult, error) { return s.result, nil }
+
+var _ = Describe("SyncWithEvents", func() {
+	It("records events related to the subject", func() {
+		owner := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: "default"}}
+		obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "child", Namespace: "default"}}
+		recorder := &eventsRecorder{}
+
+		result := SyncResult{Operation: controllerutil.OperationResultCreated}
+		result.SetEventData(eventNormal, "ChildSyncSuccessfull", "100% created")
+
+		Expect(SyncWithEvents(context.TODO(), &stubSyncer{owner: owner, obj: obj, result: result}, recorder)).To(Succeed())
+		Expect(recorder.events).To(Equal([]recordedEventsEvent{{
+			regarding: owner,
+			related:   obj,
+			eventtype: eventNormal,
+			reason:    "ChildSyncSuccessfull",
+			action:    "created",
+			note:      "100% created",
+		}}))
+	})
+})
@@ -19,10 +19,14 @@ package syncer
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/presslabs/controller-util/pkg/meta"
 )
 
 type externalSyncer struct {
@@ -30,6 +34,15 @@ type externalSyncer struct {
 	obj    interface{}
 	owner  runtime.Object
 	syncFn func(context.Context, interface{}) (controllerutil.OperationResult, error)
+
+	// dryRunAware syncers have their syncFn called in dry-run mode too
+	dryRunAware bool
+
+	// deleteFn deletes the external resource when the owner is deleted,
+	// while the owner has the finalizer, if set
+	deleteFn  func(context.Context, interface{}) error
+	finalizer string
+	client    client.Client
 }
 
 func (s *externalSyncer) Object() interface{} {
@@ -44,19 +57,35 @@ func (s *externalSyncer) ObjectOwner() runtime.Object {
 	return s.owner
 }
 
+// writesShared returns true when the syncer manages the owner finalizer.
+func (s *externalSyncer) writesShared() bool {
+	return s.deleteFn != nil && s.finalizer != ""
+}
+
 func (s *externalSyncer) Sync(ctx context.Context) (SyncResult, error) {
 	var err error
 
 	log := logf.FromContext(ctx, "syncer", s.name)
 
 	result := SyncResult{}
-	result.Operation, err = s.syncFn(ctx, s.obj)
+	start := time.Now()
 
-	if err != nil {
+	if isPaused(s.owner) || isPaused(s.obj) {
+		result.Operation = OperationResultPaused
+	} else {
+		result.Operation, err = s.sync(ctx)
+	}
+
+	observeSync(ctx, s.name, s.ObjectType(), result.Operation, err, start)
+
+	switch {
+	case err != nil:
 		result.SetEventData(eventWarning, basicEventReason(s.name, err),
 			fmt.Sprintf("%s failed syncing: %s", s.ObjectType(), err))
 		log.Error(err, string(result.Operation), "kind", s.ObjectType())
-	} else {
+	case result.Operation == OperationResultPaused:
+		setPaused(ctx, &result, s.name, s.ObjectType())
+	default:
 		result.SetEventData(eventNormal, basicEventReason(s.name, err),
 			fmt.Sprintf("%s successfully %s", s.ObjectType(), result.Operation))
 		log.V(1).Info(string(result.Operation), "kind", s.ObjectType())
@@ -65,10 +94,51 @@ func (s *externalSyncer) Sync(ctx context.Context) (SyncResult, error) {
 	return result, err
 }
 
+func (s *externalSyncer) sync(ctx context.Context) (controllerutil.OperationResult, error) {
+	owner, _ := s.owner.(client.Object)
+
+	if s.deleteFn != nil && owner != nil && !owner.GetDeletionTimestamp().IsZero() {
+		return s.delete(ctx, owner)
+	}
+
+	if IsDryRun(ctx) && !s.dryRunAware {
+		return OperationResultSkipped, nil
+	}
+
+	if s.deleteFn != nil && owner != nil {
+		if err := updateOwnerFinalizer(ctx, s.client, owner, s.finalizer, meta.AddObjectFinalizer); err != nil {
+			return controllerutil.OperationResultNone, fmt.Errorf("error when adding finalizer: %w", err)
+		}
+	}
+
+	return s.syncFn(ctx, s.obj)
+}
+
+// delete calls the deleteFn and then removes the owner finalizer.
+func (s *externalSyncer) delete(ctx context.Context, owner client.Object) (controllerutil.OperationResult, error) {
+	if s.finalizer != "" && !meta.HasObjectFinalizer(owner, s.finalizer) {
+		// the external resource was already deleted
+		return controllerutil.OperationResultNone, nil
+	}
+
+	if !IsDryRun(ctx) {
+		if err := s.deleteFn(ctx, s.obj); err != nil {
+			return controllerutil.OperationResultNone, fmt.Errorf("error when deleting resource: %w", err)
+		}
+	}
+
+	if err := updateOwnerFinalizer(ctx, s.client, owner, s.finalizer, meta.RemoveObjectFinalizer); err != nil {
+		return OperationResultDeleted, fmt.Errorf("error when removing finalizer: %w", err)
+	}
+
+	return OperationResultDeleted, nil
+}
+
 // NewExternalSyncer creates a new syncer which syncs a generic object
 // persisting it's state into and external store The name is used for logging
 // and event emitting purposes and should be an valid go identifier in upper
-// camel case. (eg. GiteaRepo).
+// camel case. (eg. GiteaRepo). The syncFn is not called in dry-run mode (see
+// WithDryRun) and the syncer reports OperationResultSkipped instead.
 func NewExternalSyncer(
 	name string, owner runtime.Object, obj interface{}, syncFn func(context.Context, interface{}) (controllerutil.OperationResult, error),
 ) Interface {
@@ -79,3 +149,40 @@ func NewExternalSyncer(
 		syncFn: syncFn,
 	}
 }
+
+// NewDryRunAwareExternalSyncer creates a new syncer just like
+// NewExternalSyncer, except that the syncFn is called in dry-run mode too, so
+// it can report the operation it would do. The syncFn must check IsDryRun and
+// skip persisting changes.
+func NewDryRunAwareExternalSyncer(
+	name string, owner runtime.Object, obj interface{}, syncFn func(context.Context, interface{}) (controllerutil.OperationResult, error),
+) Interface {
+	return &externalSyncer{
+		name:        name,
+		obj:         obj,
+		owner:       owner,
+		syncFn:      syncFn,
+		dryRunAware: true,
+	}
+}
+
+// NewExternalSyncerWithFinalizer creates a new syncer just like
+// NewExternalSyncer, which also deletes the external resource using the
+// deleteFn when the owner is being deleted. The finalizer is added to the
+// owner, using the client, to prevent its deletion until the deleteFn
+// succeeded. The deleteFn is not called in dry-run mode.
+func NewExternalSyncerWithFinalizer(
+	name string, owner client.Object, obj interface{}, c client.Client, finalizer string,
+	syncFn func(context.Context, interface{}) (controllerutil.OperationResult, error),
+	deleteFn func(context.Context, interface{}) error,
+) Interface {
+	return &externalSyncer{
+		name:      name,
+		obj:       obj,
+		owner:     owner,
+		syncFn:    syncFn,
+		deleteFn:  deleteFn,
+		finalizer: finalizer,
+		client:    c,
+	}
+}
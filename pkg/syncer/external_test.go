@@ -0,0 +1,132 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/presslabs/controller-util/pkg/syncer"
+)
+
+var _ = Describe("ExternalSyncer", func() {
+	var calls []bool
+
+	syncFn := func(ctx context.Context, _ interface{}) (controllerutil.OperationResult, error) {
+		calls = append(calls, syncer.IsDryRun(ctx))
+
+		return controllerutil.OperationResultCreated, nil
+	}
+
+	BeforeEach(func() {
+		calls = nil
+	})
+
+	It("does not call the syncFn in dry-run mode", func() {
+		result, err := syncer.NewExternalSyncer("ExampleRepo", nil, "repo", syncFn).Sync(syncer.WithDryRun(context.TODO()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Operation).To(Equal(syncer.OperationResultSkipped))
+		Expect(calls).To(BeEmpty())
+
+		result, err = syncer.NewExternalSyncer("ExampleRepo", nil, "repo", syncFn).Sync(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Operation).To(Equal(controllerutil.OperationResultCreated))
+		Expect(calls).To(Equal([]bool{false}))
+	})
+
+	It("calls the syncFn of dry-run aware syncers in dry-run mode", func() {
+		result, err := syncer.NewDryRunAwareExternalSyncer("ExampleRepo", nil, "repo", syncFn).Sync(syncer.WithDryRun(context.TODO()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Operation).To(Equal(controllerutil.OperationResultCreated))
+		Expect(calls).To(Equal([]bool{true}))
+	})
+
+	When("managing the owner finalizer", func() {
+		var (
+			recorder  *record.FakeRecorder
+			owner     *corev1.ConfigMap
+			key       types.NamespacedName
+			deleted   []interface{}
+			deleteErr error
+		)
+
+		newFinalizerSyncer := func() syncer.Interface {
+			return syncer.NewExternalSyncerWithFinalizer("ExampleRepo", owner, "repo", c, "example.com/repo", syncFn,
+				func(_ context.Context, obj interface{}) error {
+					if deleteErr != nil {
+						return deleteErr
+					}
+
+					deleted = append(deleted, obj)
+
+					return nil
+				})
+		}
+
+		BeforeEach(func() {
+			r := rand.Int31() //nolint: gosec
+
+			key = types.NamespacedName{
+				Name:      fmt.Sprintf("example-%d", r),
+				Namespace: fmt.Sprintf("default-%d", r),
+			}
+			recorder = record.NewFakeRecorder(100)
+			owner = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+			deleted = nil
+			deleteErr = nil
+
+			Expect(c.Create(context.TODO(), &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: key.Namespace}})).To(Succeed())
+			Expect(c.Create(context.TODO(), owner)).To(Succeed())
+		})
+
+		It("deletes the external resource before releasing the owner", func() {
+			Expect(syncer.Sync(context.TODO(), newFinalizerSyncer(), recorder)).To(Succeed())
+			Expect(c.Get(context.TODO(), key, owner)).To(Succeed())
+			Expect(owner.Finalizers).To(ConsistOf("example.com/repo"))
+			Expect(calls).To(HaveLen(1))
+
+			Expect(c.Delete(context.TODO(), owner)).To(Succeed())
+			Expect(c.Get(context.TODO(), key, owner)).To(Succeed())
+
+			deleteErr = errSyncFailed
+			Expect(syncer.Sync(context.TODO(), newFinalizerSyncer(), recorder)).NotTo(Succeed())
+			Expect(c.Get(context.TODO(), key, owner)).To(Succeed())
+			Expect(owner.Finalizers).To(ConsistOf("example.com/repo"))
+
+			deleteErr = nil
+			Expect(syncer.Sync(context.TODO(), newFinalizerSyncer(), recorder)).To(Succeed())
+			Expect(deleted).To(ConsistOf("repo"))
+			Expect(calls).To(HaveLen(1))
+			Expect(k8serrors.IsNotFound(c.Get(context.TODO(), key, &corev1.ConfigMap{}))).To(BeTrue())
+
+			Expect(<-recorder.Events).To(ContainSubstring("ExampleRepoSyncSuccessfull"))
+			Expect(<-recorder.Events).To(ContainSubstring("Warning ExampleRepoSyncFailed"))
+			Expect(<-recorder.Events).To(ContainSubstring("successfully deleted"))
+		})
+	})
+})
@@ -0,0 +1,267 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"k8s.io/client-go/tools/events"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+var (
+	// ErrDependencyFailed is set on the results of the syncers which were not
+	// run because one of their dependencies failed.
+	ErrDependencyFailed = errors.New("dependency failed")
+
+	// ErrDependencyNotReady is set on the results of the syncers which were
+	// not run because one of their dependencies is still in progress (eg. a
+	// RemoveResourceSyncer waiting for the object to be gone).
+	ErrDependencyNotReady = errors.New("dependency not ready")
+
+	errUnknownDependency = errors.New("dependency is not part of the group")
+	errDependencyCycle   = errors.New("dependency cycle")
+)
+
+// GroupItemResult is the outcome of a syncer run as part of a Group.
+type GroupItemResult struct {
+	Syncer Interface
+	Result SyncResult
+	Err    error
+	// Skipped is true when the syncer was not run because one of its
+	// dependencies failed or is not ready.
+	Skipped bool
+}
+
+// GroupResult is the result of a Group sync.
+type GroupResult struct {
+	// Items holds the result of each syncer, in the order they were added to the group.
+	Items []GroupItemResult
+}
+
+// For returns the result of the given syncer.
+func (r GroupResult) For(syncer Interface) (GroupItemResult, bool) {
+	for _, item := range r.Items {
+		if item.Syncer == syncer {
+			return item, true
+		}
+	}
+
+	return GroupItemResult{}, false
+}
+
+type groupMember struct {
+	syncer    Interface
+	dependsOn []Interface
+}
+
+// Group runs a set of syncers, recording their events like Sync does. Syncers
+// which don't depend on each other are run in parallel, while a syncer is run
+// only after all its dependencies synced successfully and are ready (see
+// ErrDependencyNotReady). The syncers which write to the owner shared with
+// the other syncers (the StatusSyncer and the syncers managing an owner
+// finalizer) are run alone, while no other syncer runs.
+type Group struct {
+	sink    eventSink
+	members []groupMember
+}
+
+// NewGroup creates a new empty syncer group which records events using the
+// given recorder.
+func NewGroup(recorder record.EventRecorder) *Group {
+	return &Group{
+		sink: legacyEvents(recorder),
+	}
+}
+
+// NewGroupWithEvents creates a new empty syncer group which records events
+// using the given events.k8s.io/v1 recorder, like SyncWithEvents does.
+func NewGroupWithEvents(recorder events.EventRecorder) *Group {
+	return &Group{
+		sink: newEvents(recorder),
+	}
+}
+
+// Add adds a syncer to the group. The syncer is run after all syncers it
+// depends on, which must be added to the group too, synced successfully.
+func (g *Group) Add(syncer Interface, dependsOn ...Interface) *Group {
+	g.members = append(g.members, groupMember{
+		syncer:    syncer,
+		dependsOn: dependsOn,
+	})
+
+	return g
+}
+
+// Sync runs all syncers of the group and returns their results. The returned
+// error joins the errors of all failed syncers.
+func (g *Group) Sync(ctx context.Context) (GroupResult, error) {
+	deps, err := g.dependencies()
+	if err != nil {
+		return GroupResult{}, err
+	}
+
+	items := make([]GroupItemResult, len(g.members))
+	done := make([]chan struct{}, len(g.members))
+
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+
+	var (
+		wg   sync.WaitGroup
+		lock sync.RWMutex
+	)
+
+	for i, member := range g.members {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			defer close(done[i])
+
+			items[i].Syncer = member.syncer
+
+			for _, dep := range deps[i] {
+				<-done[dep]
+
+				switch {
+				case items[i].Skipped:
+				case items[dep].Skipped:
+					items[i].Err = items[dep].Err
+					items[i].Skipped = true
+				case items[dep].Err != nil:
+					items[i].Err = fmt.Errorf("%w: %w", ErrDependencyFailed, items[dep].Err)
+					items[i].Skipped = true
+				case inProgress(items[dep].Result.Operation):
+					items[i].Err = fmt.Errorf("%w: %s", ErrDependencyNotReady, items[dep].Result.Operation)
+					items[i].Skipped = true
+				}
+			}
+
+			if !items[i].Skipped {
+				items[i].Result, items[i].Err = g.syncLocked(ctx, &lock, member.syncer)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	errs := []error{}
+
+	for _, item := range items {
+		if item.Err != nil && !item.Skipped {
+			errs = append(errs, item.Err)
+		}
+	}
+
+	return GroupResult{Items: items}, errors.Join(errs...)
+}
+
+// syncLocked runs the syncer holding the lock exclusively when it writes to
+// the objects shared with the other syncers, and shared otherwise.
+func (g *Group) syncLocked(ctx context.Context, lock *sync.RWMutex, syncer Interface) (SyncResult, error) {
+	if isSharedWriter(syncer) {
+		lock.Lock()
+		defer lock.Unlock()
+	} else {
+		lock.RLock()
+		defer lock.RUnlock()
+	}
+
+	return syncAndRecord(ctx, syncer, g.sink)
+}
+
+// sharedWriter is implemented by the syncers which write, while syncing, to
+// objects other syncers read, usually their owner.
+type sharedWriter interface {
+	writesShared() bool
+}
+
+// isSharedWriter returns true if the syncer writes to objects shared with
+// other syncers.
+func isSharedWriter(syncer interface{}) bool {
+	w, ok := syncer.(sharedWriter)
+
+	return ok && w.writesShared()
+}
+
+// inProgress returns true for the operations which are not done yet, so the
+// dependents of the syncer must wait for a following sync.
+func inProgress(op controllerutil.OperationResult) bool {
+	return op == OperationResultDeleting || op == OperationResultRecreating
+}
+
+// dependencies resolves the dependencies of each member to member indexes and
+// ensures there are no cycles between them.
+func (g *Group) dependencies() ([][]int, error) {
+	index := make(map[Interface]int, len(g.members))
+	for i, member := range g.members {
+		index[member.syncer] = i
+	}
+
+	deps := make([][]int, len(g.members))
+	pending := make([]int, len(g.members))
+	dependents := make([][]int, len(g.members))
+
+	for i, member := range g.members {
+		for _, dep := range member.dependsOn {
+			j, ok := index[dep]
+			if !ok {
+				return nil, fmt.Errorf("%w: %T", errUnknownDependency, dep)
+			}
+
+			deps[i] = append(deps[i], j)
+			dependents[j] = append(dependents[j], i)
+			pending[i]++
+		}
+	}
+
+	// Kahn's algorithm: all members are visited only if there are no cycles
+	ready := []int{}
+
+	for i := range pending {
+		if pending[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+
+	visited := 0
+
+	for len(ready) > 0 {
+		i := ready[0]
+		ready = ready[1:]
+		visited++
+
+		for _, j := range dependents[i] {
+			pending[j]--
+			if pending[j] == 0 {
+				ready = append(ready, j)
+			}
+		}
+	}
+
+	if visited != len(g.members) {
+		return nil, errDependencyCycle
+	}
+
+	return deps, nil
+}
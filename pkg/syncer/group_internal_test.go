@@ -0,0 +1,78 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// The specs below are meant to be run with the race detector (go test -race).
+var _ = Describe("Group syncing the owner", func() {
+	var (
+		c     client.Client
+		owner *appsv1.Deployment
+		group *Group
+	)
+
+	BeforeEach(func() {
+		owner = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: "default"}}
+		c = fake.NewClientBuilder().WithObjects(owner.DeepCopy()).WithStatusSubresource(owner).Build()
+		Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(owner), owner)).To(Succeed())
+
+		group = NewGroup(record.NewFakeRecorder(100))
+
+		for i := range 3 {
+			obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("child-%d", i), Namespace: "default"}}
+			group.Add(NewObjectSyncer("Child", owner, obj, c, func() error { return nil }))
+		}
+	})
+
+	It("doesn't race with a status syncer of the owner", func() {
+		group.Add(&StatusSyncer{Name: "Status", Obj: owner, Client: c, MutateFn: func() error {
+			owner.Status.ObservedGeneration = 1
+
+			return nil
+		}})
+
+		_, err := group.Sync(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(owner.Status.ObservedGeneration).To(BeEquivalentTo(1))
+	})
+
+	It("doesn't race with a syncer managing the owner finalizer", func() {
+		group.Add(NewExternalSyncerWithFinalizer("Repo", owner, "repo", c, "example.com/repo",
+			func(context.Context, interface{}) (controllerutil.OperationResult, error) {
+				return controllerutil.OperationResultCreated, nil
+			}, func(context.Context, interface{}) error { return nil }))
+
+		_, err := group.Sync(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(owner.Finalizers).To(ConsistOf("example.com/repo"))
+	})
+})
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/presslabs/controller-util/pkg/syncer"
+)
+
+var errSyncFailed = errors.New("sync failed")
+
+var _ = Describe("Group", func() {
+	var (
+		recorder *record.FakeRecorder
+		owner    *corev1.ConfigMap
+		mu       sync.Mutex
+		order    []string
+	)
+
+	newSyncer := func(name string, err error) syncer.Interface {
+		return syncer.NewExternalSyncer(name, owner, name, func(context.Context, interface{}) (controllerutil.OperationResult, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			order = append(order, name)
+
+			return controllerutil.OperationResultUpdated, err
+		})
+	}
+
+	BeforeEach(func() {
+		recorder = record.NewFakeRecorder(100)
+		owner = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "owner",
+				Namespace: "default",
+			},
+		}
+		order = nil
+	})
+
+	It("runs syncers after their dependencies", func() {
+		a := newSyncer("A", nil)
+		b := newSyncer("B", nil)
+		d := newSyncer("C", nil)
+
+		result, err := syncer.NewGroup(recorder).Add(d, a, b).Add(b, a).Add(a).Sync(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(order).To(Equal([]string{"A", "B", "C"}))
+		Expect(result.Items).To(HaveLen(3))
+
+		item, found := result.For(b)
+		Expect(found).To(BeTrue())
+		Expect(item.Result.Operation).To(Equal(controllerutil.OperationResultUpdated))
+
+		Expect(recorder.Events).To(HaveLen(3))
+	})
+
+	It("skips the dependents of a failed syncer and joins the errors", func() {
+		a := newSyncer("A", errSyncFailed)
+		b := newSyncer("B", nil)
+		d := newSyncer("C", nil)
+
+		result, err := syncer.NewGroup(recorder).Add(a).Add(b, a).Add(d).Sync(context.TODO())
+		Expect(err).To(MatchError(errSyncFailed))
+		Expect(order).To(ConsistOf("A", "C"))
+
+		item, found := result.For(b)
+		Expect(found).To(BeTrue())
+		Expect(item.Skipped).To(BeTrue())
+		Expect(item.Err).To(MatchError(syncer.ErrDependencyFailed))
+	})
+
+	It("skips the dependents of a syncer which is still deleting", func() {
+		statefulSet := syncer.NewExternalSyncer("StatefulSet", owner, "sts", func(context.Context, interface{}) (controllerutil.OperationResult, error) {
+			return syncer.OperationResultDeleting, nil
+		})
+		pvc := newSyncer("PVC", nil)
+
+		result, err := syncer.NewGroup(recorder).Add(statefulSet).Add(pvc, statefulSet).Sync(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(order).To(BeEmpty())
+
+		item, found := result.For(pvc)
+		Expect(found).To(BeTrue())
+		Expect(item.Skipped).To(BeTrue())
+		Expect(item.Err).To(MatchError(syncer.ErrDependencyNotReady))
+	})
+
+	It("fails on dependency cycles", func() {
+		a := newSyncer("A", nil)
+		b := newSyncer("B", nil)
+
+		_, err := syncer.NewGroup(recorder).Add(a, b).Add(b, a).Sync(context.TODO())
+		Expect(err).To(HaveOccurred())
+		Expect(order).To(BeEmpty())
+	})
+})
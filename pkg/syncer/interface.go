@@ -29,6 +29,30 @@ type SyncResult struct {
 	EventType    string
 	EventReason  string
 	EventMessage string
+	// Diff lists the differences between the persisted object and the synced
+	// one, with sensitive data redacted. It is set only by syncers which
+	// compute it.
+	Diff []string
+	// Changes holds the same differences as Diff, as structured field changes.
+	Changes []FieldChange
+	// Attempts is the number of times the sync was tried. It is set only by
+	// syncers which retry on failures.
+	Attempts int
+	// Events are recorded for the owner in addition to the event described
+	// by the event data, eg. one per object deleted by a syncer.
+	Events []Event
+	// Observed is the observed state of the subject. It is set only by
+	// syncers which observe external resources.
+	Observed interface{}
+}
+
+// Event describes a kubernetes event.
+type Event struct {
+	Type    string
+	Reason  string
+	Message string
+	// Related is the object the event is about, besides the owner.
+	Related runtime.Object
 }
 
 // SetEventData sets event data on an SyncResult.
@@ -51,3 +75,34 @@ type Interface interface {
 	// Sync persists data into the external store.
 	Sync(ctx context.Context) (SyncResult, error)
 }
+
+// TypedInterface represents a syncer whose subject has a known type. Use
+// Untyped to pass it where a syncer.Interface is expected.
+type TypedInterface[T any] interface {
+	// Object returns the object for which sync applies.
+	Object() T
+
+	// Owner returns the object owner or nil if object does not have one.
+	ObjectOwner() runtime.Object
+
+	// Sync persists data into the external store.
+	Sync(ctx context.Context) (SyncResult, error)
+}
+
+type untyped[T any] struct {
+	TypedInterface[T]
+}
+
+func (s untyped[T]) Object() interface{} {
+	return s.TypedInterface.Object()
+}
+
+func (s untyped[T]) writesShared() bool {
+	return isSharedWriter(s.TypedInterface)
+}
+
+// Untyped returns the syncer.Interface of a typed syncer, so it can be mixed
+// with the other syncers (eg. in a Group).
+func Untyped[T any](syncer TypedInterface[T]) Interface {
+	return untyped[T]{TypedInterface: syncer}
+}
@@ -0,0 +1,273 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"strings"
+	"text/template"
+
+	"github.com/iancoleman/strcase"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// NewManifestSyncers creates a syncer for each object defined in the YAML
+// manifests from fsys matching the pattern (see fs.Glob). The manifests are
+// text/template templates, rendered with the given data, and may contain
+// multiple documents. The objects are decoded into the typed objects known by
+// the client scheme, falling back to unstructured objects, and are persisted
+// using server-side apply. The namespaced objects without a namespace are
+// created in the owner namespace. The returned syncers are *ObjectSyncer, so
+// their options (eg. Ownership or Strategy) can be changed before syncing
+// them. Their SyncFn sets the fields defined by the manifest on the object,
+// leaving the other fields, including the ones defaulted by the API server,
+// as they are (see mergeFields). Server-side apply remains the recommended
+// strategy, as it's the only one which removes the fields dropped from the
+// manifests.
+// The name is used for logging and event emitting purposes and should be an
+// valid go identifier in upper camel case. (eg. MysqlManifests). Each syncer
+// is named after it, the object kind and the object name (eg.
+// MysqlManifestsConfigMapMysqlConfig), so the events, the metrics and the
+// conditions of the objects are distinct.
+func NewManifestSyncers(
+	name string, owner client.Object, c client.Client, fsys fs.FS, pattern string, data interface{},
+) ([]Interface, error) {
+	paths, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	syncers := []Interface{}
+
+	for _, path := range paths {
+		objs, err := decodeManifest(fsys, path, data, c.Scheme())
+		if err != nil {
+			return nil, fmt.Errorf("error when decoding manifest %s: %w", path, err)
+		}
+
+		for _, obj := range objs {
+			if err := defaultNamespace(obj, owner, c); err != nil {
+				return nil, fmt.Errorf("error when decoding manifest %s: %w", path, err)
+			}
+
+			syncers = append(syncers, &ObjectSyncer{
+				Owner:    owner,
+				Obj:      obj,
+				SyncFn:   manifestSyncFn(obj),
+				Name:     manifestSyncerName(name, obj),
+				Client:   c,
+				Strategy: ServerSideApplyStrategy,
+			})
+		}
+	}
+
+	return syncers, nil
+}
+
+// manifestSyncerName returns the name of the syncer of an object defined by
+// the manifests.
+func manifestSyncerName(name string, obj client.Object) string {
+	return strcase.ToCamel(strings.Join([]string{name, obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName()}, " "))
+}
+
+// manifestSyncFn returns a SyncFn which sets the fields defined by the
+// manifest on obj. The strategies which read the live object into obj before
+// calling the SyncFn would otherwise persist the live object unchanged.
+func manifestSyncFn(obj client.Object) func() error {
+	desired, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj.DeepCopyObject())
+
+	return func() error {
+		if err != nil {
+			return err
+		}
+
+		if u, ok := obj.(*unstructured.Unstructured); ok {
+			mergeFields(u.Object, desired)
+
+			return nil
+		}
+
+		live, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return err
+		}
+
+		mergeFields(live, desired)
+
+		return runtime.DefaultUnstructuredConverter.FromUnstructured(live, obj)
+	}
+}
+
+// mergeFields sets the fields of desired on obj. The nested maps are merged,
+// as are the lists whose items match (see mergeList), and any other value is
+// replaced. The null values (eg. the creationTimestamp of typed objects) are
+// skipped.
+func mergeFields(obj, desired map[string]interface{}) {
+	for key, value := range desired {
+		if value == nil {
+			continue
+		}
+
+		switch desiredValue := value.(type) {
+		case map[string]interface{}:
+			if objMap, ok := obj[key].(map[string]interface{}); ok {
+				mergeFields(objMap, desiredValue)
+
+				continue
+			}
+		case []interface{}:
+			if objList, ok := obj[key].([]interface{}); ok && mergeList(objList, desiredValue) {
+				continue
+			}
+		}
+
+		obj[key] = runtime.DeepCopyJSONValue(value)
+	}
+}
+
+// mergeList merges the items of desired into the items of obj, keeping the
+// fields defaulted by the API server (eg. the imagePullPolicy of containers).
+// The items are merged only when both lists have the same length and each
+// pair of items are objects with the same name, or both without a name. It
+// returns false, leaving obj unchanged, when the items don't match.
+func mergeList(obj, desired []interface{}) bool {
+	if len(obj) != len(desired) {
+		return false
+	}
+
+	for i := range desired {
+		objItem, ok := obj[i].(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		desiredItem, ok := desired[i].(map[string]interface{})
+		if !ok || objItem["name"] != desiredItem["name"] {
+			return false
+		}
+	}
+
+	for i := range desired {
+		mergeFields(obj[i].(map[string]interface{}), desired[i].(map[string]interface{})) //nolint: forcetypeassert
+	}
+
+	return true
+}
+
+// decodeManifest renders the manifest at path and decodes its documents.
+func decodeManifest(fsys fs.FS, path string, data interface{}, scheme *runtime.Scheme) ([]client.Object, error) {
+	content, err := fs.ReadFile(fsys, path)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New(path).Option("missingkey=error").Parse(string(content))
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := &bytes.Buffer{}
+	if err := tmpl.Execute(rendered, data); err != nil {
+		return nil, err
+	}
+
+	objs := []client.Object{}
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(rendered))
+
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return objs, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		obj, err := decodeObject(doc, scheme)
+		if err != nil {
+			return nil, err
+		}
+
+		if obj != nil {
+			objs = append(objs, obj)
+		}
+	}
+}
+
+// decodeObject decodes a YAML document into a typed object when its kind is
+// known by the scheme and into an unstructured object otherwise. It returns
+// nil for empty documents.
+func decodeObject(doc []byte, scheme *runtime.Scheme) (client.Object, error) {
+	content := map[string]interface{}{}
+	if err := yaml.Unmarshal(doc, &content); err != nil {
+		return nil, err
+	}
+
+	if len(content) == 0 {
+		return nil, nil //nolint: nilnil
+	}
+
+	u := &unstructured.Unstructured{Object: content}
+
+	typed, err := scheme.New(u.GroupVersionKind())
+	if err != nil {
+		if runtime.IsNotRegisteredError(err) {
+			return u, nil
+		}
+
+		return nil, err
+	}
+
+	obj, ok := typed.(client.Object)
+	if !ok {
+		return u, nil
+	}
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(content, obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// defaultNamespace sets the owner namespace on the namespaced objects without one.
+func defaultNamespace(obj, owner client.Object, c client.Client) error {
+	if obj.GetNamespace() != "" || owner == nil || owner.GetNamespace() == "" {
+		return nil
+	}
+
+	namespaced, err := c.IsObjectNamespaced(obj)
+	if err != nil {
+		return err
+	}
+
+	if namespaced {
+		obj.SetNamespace(owner.GetNamespace())
+	}
+
+	return nil
+}
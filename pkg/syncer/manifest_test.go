@@ -0,0 +1,198 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"testing/fstest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/presslabs/controller-util/pkg/syncer"
+)
+
+const manifest = `# the application config
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Name }}-config
+data:
+  replicas: "{{ .Replicas }}"
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: {{ .Name }}-secret
+stringData:
+  password: secret
+`
+
+const deploymentManifest = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ .Name }}
+spec:
+  selector:
+    matchLabels:
+      app: {{ .Name }}
+  template:
+    metadata:
+      labels:
+        app: {{ .Name }}
+    spec:
+      containers:
+      - name: main
+        image: busybox
+        ports:
+        - containerPort: 8080
+`
+
+var _ = Describe("NewManifestSyncers", func() {
+	var (
+		recorder *record.FakeRecorder
+		owner    *corev1.ConfigMap
+		key      types.NamespacedName
+		fsys     fstest.MapFS
+	)
+
+	BeforeEach(func() {
+		r := rand.Int31() //nolint: gosec
+
+		key = types.NamespacedName{
+			Name:      fmt.Sprintf("example-%d", r),
+			Namespace: fmt.Sprintf("default-%d", r),
+		}
+		recorder = record.NewFakeRecorder(100)
+		owner = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+		}
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: key.Namespace,
+			},
+		}
+		Expect(c.Create(context.TODO(), ns)).To(Succeed())
+		Expect(c.Create(context.TODO(), owner)).To(Succeed())
+
+		fsys = fstest.MapFS{
+			"manifests/app.yaml":  {Data: []byte(manifest)},
+			"manifests/README.md": {Data: []byte("not a manifest")},
+		}
+	})
+
+	It("syncs the objects defined by the manifests", func() {
+		data := map[string]interface{}{"Name": key.Name, "Replicas": 3}
+
+		syncers, err := syncer.NewManifestSyncers("ExampleManifests", owner, c, fsys, "manifests/*.yaml", data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(syncers).To(HaveLen(2))
+		Expect(syncers[0].Object()).To(BeAssignableToTypeOf(&corev1.ConfigMap{}))
+		Expect(syncers[1].Object()).To(BeAssignableToTypeOf(&corev1.Secret{}))
+
+		for _, s := range syncers {
+			Expect(syncer.Sync(context.TODO(), s, recorder)).To(Succeed())
+		}
+
+		cm := &corev1.ConfigMap{}
+		Expect(c.Get(context.TODO(), types.NamespacedName{Name: key.Name + "-config", Namespace: key.Namespace}, cm)).To(Succeed())
+		Expect(cm.Data).To(HaveKeyWithValue("replicas", "3"))
+		Expect(cm.OwnerReferences).To(HaveLen(1))
+
+		secret := &corev1.Secret{}
+		Expect(c.Get(context.TODO(), types.NamespacedName{Name: key.Name + "-secret", Namespace: key.Namespace}, secret)).To(Succeed())
+		Expect(secret.Data).To(HaveKeyWithValue("password", []byte("secret")))
+	})
+
+	It("names the syncers after the objects and syncs them with any strategy", func() {
+		syncers, err := syncer.NewManifestSyncers("ExampleManifests", owner, c, fsys, "manifests/*.yaml",
+			map[string]interface{}{"Name": "example", "Replicas": 3})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(syncers).To(HaveLen(2))
+
+		names := []string{}
+
+		for _, s := range syncers {
+			objSyncer, convOk := s.(*syncer.ObjectSyncer)
+			Expect(convOk).To(BeTrue())
+
+			names = append(names, objSyncer.Name)
+			Expect(syncer.Sync(context.TODO(), objSyncer, recorder)).To(Succeed())
+		}
+
+		Expect(names).To(Equal([]string{"ExampleManifestsConfigMapExampleConfig", "ExampleManifestsSecretExampleSecret"}))
+
+		for replicas, strategy := range map[int]syncer.SyncStrategy{4: syncer.CreateOrUpdateStrategy, 5: syncer.CreateOrPatchStrategy} {
+			syncers, err = syncer.NewManifestSyncers("ExampleManifests", owner, c, fsys, "manifests/*.yaml",
+				map[string]interface{}{"Name": "example", "Replicas": replicas})
+			Expect(err).NotTo(HaveOccurred())
+
+			objSyncer, convOk := syncers[0].(*syncer.ObjectSyncer)
+			Expect(convOk).To(BeTrue())
+
+			objSyncer.Strategy = strategy
+			Expect(syncer.Sync(context.TODO(), objSyncer, recorder)).To(Succeed())
+
+			cm := &corev1.ConfigMap{}
+			Expect(c.Get(context.TODO(), types.NamespacedName{Name: "example-config", Namespace: key.Namespace}, cm)).To(Succeed())
+			Expect(cm.Data).To(HaveKeyWithValue("replicas", strconv.Itoa(replicas)))
+		}
+	})
+
+	It("leaves the objects unchanged on the following syncs with CreateOrUpdateStrategy", func() {
+		fsys["deployments/app.yaml"] = &fstest.MapFile{Data: []byte(deploymentManifest)}
+
+		for _, op := range []controllerutil.OperationResult{controllerutil.OperationResultCreated, controllerutil.OperationResultNone} {
+			syncers, err := syncer.NewManifestSyncers("ExampleManifests", owner, c, fsys, "deployments/*.yaml",
+				map[string]interface{}{"Name": key.Name})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(syncers).To(HaveLen(1))
+
+			objSyncer, convOk := syncers[0].(*syncer.ObjectSyncer)
+			Expect(convOk).To(BeTrue())
+
+			objSyncer.Strategy = syncer.CreateOrUpdateStrategy
+
+			result, err := objSyncer.Sync(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Operation).To(Equal(op))
+		}
+
+		deployment := &appsv1.Deployment{}
+		Expect(c.Get(context.TODO(), key, deployment)).To(Succeed())
+		Expect(deployment.Spec.Template.Spec.Containers[0].ImagePullPolicy).NotTo(BeEmpty())
+		Expect(deployment.Spec.Template.Spec.Containers[0].Ports[0].Protocol).To(Equal(corev1.ProtocolTCP))
+	})
+
+	It("fails for missing template data", func() {
+		_, err := syncer.NewManifestSyncers("ExampleManifests", owner, c, fsys, "manifests/*.yaml", map[string]interface{}{})
+		Expect(err).To(MatchError(ContainSubstring("manifests/app.yaml")))
+	})
+})
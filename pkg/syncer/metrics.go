@@ -0,0 +1,89 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	errorReasonIgnored      = "ignored"
+	errorReasonOwnerDeleted = "owner_deleted"
+	errorReasonFailed       = "failed"
+)
+
+var (
+	syncOperations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "controller_util_syncer_operations_total",
+		Help: "Total number of successful syncs per syncer, kind and operation result.",
+	}, []string{"syncer", "kind", "operation"})
+
+	syncErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "controller_util_syncer_errors_total",
+		Help: "Total number of sync errors per syncer, kind and reason (ignored, owner_deleted or failed).",
+	}, []string{"syncer", "kind", "reason"})
+
+	syncDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "controller_util_syncer_sync_duration_seconds",
+		Help:    "Duration of syncs per syncer.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"syncer"})
+)
+
+func init() { //nolint: gochecknoinits
+	metrics.Registry.MustRegister(syncOperations, syncErrors, syncDuration)
+}
+
+// observeSync records the metrics of a sync which started at the given time.
+// The error is the one returned by the sync, before ignored errors are dropped.
+// Dry-run syncs are not recorded, as they don't change anything.
+func observeSync(ctx context.Context, name, kind string, op controllerutil.OperationResult, err error, start time.Time) {
+	if IsDryRun(ctx) {
+		return
+	}
+
+	syncDuration.WithLabelValues(name).Observe(time.Since(start).Seconds())
+
+	if reason := errorReason(err); reason != "" {
+		syncErrors.WithLabelValues(name, kind, reason).Inc()
+
+		if reason == errorReasonFailed {
+			return
+		}
+	}
+
+	syncOperations.WithLabelValues(name, kind, string(op)).Inc()
+}
+
+func errorReason(err error) string {
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, ErrOwnerDeleted):
+		return errorReasonOwnerDeleted
+	case errors.Is(err, ErrIgnore):
+		return errorReasonIgnored
+	default:
+		return errorReasonFailed
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+var _ = Describe("syncer metrics", func() {
+	newSyncer := func(name string, err error) Interface {
+		return NewExternalSyncer(name, nil, "subject", func(context.Context, interface{}) (controllerutil.OperationResult, error) {
+			return controllerutil.OperationResultUpdated, err
+		})
+	}
+
+	It("counts successful operations", func() {
+		Expect(Sync(context.TODO(), newSyncer("MetricsSuccess", nil), nil)).To(Succeed())
+
+		Expect(testutil.ToFloat64(syncOperations.WithLabelValues("MetricsSuccess", "string", "updated"))).To(Equal(1.0))
+		Expect(testutil.CollectAndCount(syncDuration)).To(BeNumerically(">=", 1))
+	})
+
+	It("counts errors by reason", func() {
+		Expect(Sync(context.TODO(), newSyncer("MetricsFailed", errors.New("failed")), nil)).NotTo(Succeed())                //nolint: err113
+		Expect(Sync(context.TODO(), newSyncer("MetricsFailed", IgnoredError(errors.New("ignored"))), nil)).NotTo(Succeed()) //nolint: err113
+
+		Expect(testutil.ToFloat64(syncErrors.WithLabelValues("MetricsFailed", "string", errorReasonFailed))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(syncErrors.WithLabelValues("MetricsFailed", "string", errorReasonIgnored))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(syncOperations.WithLabelValues("MetricsFailed", "string", "updated"))).To(Equal(1.0))
+	})
+
+	It("doesn't record dry-run syncs", func() {
+		Expect(Sync(WithDryRun(context.TODO()), NewDryRunAwareExternalSyncer("MetricsDryRun", nil, "subject",
+			func(context.Context, interface{}) (controllerutil.OperationResult, error) {
+				return controllerutil.OperationResultUpdated, nil
+			}), nil)).To(Succeed())
+
+		Expect(testutil.ToFloat64(syncOperations.WithLabelValues("MetricsDryRun", "string", "updated"))).To(Equal(0.0))
+	})
+})
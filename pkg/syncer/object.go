@@ -20,22 +20,82 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/go-test/deep"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
+// SyncStrategy selects how an ObjectSyncer persists its subject.
+type SyncStrategy string
+
+const (
+	// CreateOrUpdateStrategy persists the object using controller-runtime's
+	// CreateOrUpdate. This is the default strategy.
+	CreateOrUpdateStrategy SyncStrategy = ""
+
+	// CreateOrPatchStrategy persists the object by patching only the fields
+	// changed by the SyncFn, using a strategic merge patch for kubernetes
+	// builtin types and a JSON merge patch otherwise.
+	CreateOrPatchStrategy SyncStrategy = "CreateOrPatch"
+
+	// ServerSideApplyStrategy persists the object using server-side apply.
+	ServerSideApplyStrategy SyncStrategy = "ServerSideApply"
+)
+
 // ObjectSyncer is a syncer.Interface for syncing kubernetes.Objects only by
 // passing a SyncFn.
 type ObjectSyncer struct {
-	Owner          client.Object
-	Obj            client.Object
-	SyncFn         controllerutil.MutateFn
-	Name           string
-	Client         client.Client
+	Owner  client.Object
+	Obj    client.Object
+	SyncFn controllerutil.MutateFn
+	Name   string
+	Client client.Client
+
+	// Strategy selects how the object is persisted. Defaults to CreateOrUpdateStrategy.
+	Strategy SyncStrategy
+	// FieldManager is the field manager used for server-side apply. Defaults to DefaultFieldManager.
+	FieldManager string
+	// ForceOwnership makes server-side apply take ownership of conflicting fields.
+	ForceOwnership bool
+	// ConflictRetry enables retrying the sync, re-reading the object and
+	// re-running the SyncFn, when it fails with a conflict error.
+	// retry.DefaultRetry is a sensible default.
+	ConflictRetry *wait.Backoff
+	// APIReader is an uncached reader (eg. manager.GetAPIReader()) used for
+	// re-reading the object when retrying conflicts. The cached client
+	// usually returns the same stale object, so set it when the Client is
+	// cached. Defaults to the Client.
+	APIReader client.Reader
+	// DiffInEvent appends the paths of the changed fields to the event
+	// message when the object is updated.
+	DiffInEvent bool
+	// DiffAnnotation is the key of an annotation in which the paths of the
+	// fields changed by the last update are recorded on the object. It is not
+	// supported by the ServerSideApplyStrategy.
+	DiffAnnotation string
+	// Redactor removes sensitive data from the diffs, in addition to the
+	// fields redacted by the DefaultRedactor.
+	Redactor *Redactor
+	// Recreate enables deleting and creating again the object when updating
+	// it fails because the SyncFn changed immutable fields. While the object
+	// is still being deleted the syncer reports OperationResultRecreating.
+	Recreate *RecreatePolicy
+	// ObserveOnly makes the syncer only report whether the object drifted from
+	// the desired state, without persisting it. The SyncFn is run on the
+	// persisted object and the result is OperationResultDrifted, with the
+	// diff, when it changes it. Objects can be switched to observe-only mode
+	// using the ObserveOnlyAnnotation too.
+	ObserveOnly bool
+	// Ownership selects how the owner is recorded on the object. Defaults to
+	// ControllerOwnership.
+	Ownership OwnershipPolicy
+
 	previousObject runtime.Object
 }
 
@@ -57,10 +117,15 @@ func (s *ObjectSyncer) Sync(ctx context.Context) (SyncResult, error) {
 	log := logf.FromContext(ctx, "syncer", s.Name)
 	key := client.ObjectKeyFromObject(s.Obj)
 
-	result.Operation, err = controllerutil.CreateOrUpdate(ctx, s.Client, s.Obj, s.mutateFn())
+	start := time.Now()
+	result.Operation, result.Attempts, err = retryOnConflict(ctx, s.ConflictRetry, s.Obj, s.persist)
+	observeSync(ctx, s.Name, objectType(s.Obj, s.Client), result.Operation, err, start)
 
 	// check deep diff
-	diff := deep.Equal(redact(s.previousObject), redact(s.Obj))
+	previous, current := s.redact(s.previousObject), s.redact(s.Obj)
+	diff := deep.Equal(previous, current)
+	result.Diff = diff
+	result.Changes = fieldChanges(previous, current)
 
 	// don't pass to user error for owner deletion, just don't create the object
 	//nolint: gocritic
@@ -74,46 +139,122 @@ func (s *ObjectSyncer) Sync(ctx context.Context) (SyncResult, error) {
 		result.SetEventData(eventWarning, basicEventReason(s.Name, err),
 			fmt.Sprintf("%s %s failed syncing: %s", objectType(s.Obj, s.Client), key, err))
 		log.Error(err, string(result.Operation), "key", key, "kind", objectType(s.Obj, s.Client), "diff", diff)
+	} else if result.Operation == OperationResultPaused {
+		setPaused(ctx, &result, s.Name, fmt.Sprintf("%s %s", objectType(s.Obj, s.Client), key))
+	} else if result.Operation == OperationResultDrifted {
+		result.SetEventData(eventWarning, driftEventReason(s.Name), s.driftMessage(key, result))
+		log.Info(string(result.Operation), "key", key, "kind", objectType(s.Obj, s.Client), "diff", diff)
 	} else {
-		result.SetEventData(eventNormal, basicEventReason(s.Name, err),
-			fmt.Sprintf("%s %s %s successfully", objectType(s.Obj, s.Client), key, result.Operation))
+		result.SetEventData(eventNormal, basicEventReason(s.Name, err), s.successMessage(key, result))
 		log.V(1).Info(string(result.Operation), "key", key, "kind", objectType(s.Obj, s.Client), "diff", diff)
 	}
 
 	return result, err
 }
 
+// successMessage returns the event message for a successful sync.
+func (s *ObjectSyncer) successMessage(key client.ObjectKey, result SyncResult) string {
+	msg := fmt.Sprintf("%s %s %s successfully", objectType(s.Obj, s.Client), key, result.Operation)
+
+	updated := result.Operation != controllerutil.OperationResultNone && result.Operation != controllerutil.OperationResultCreated
+	if s.DiffInEvent && updated && len(result.Changes) > 0 {
+		msg += fmt.Sprintf(" (changed %s)", describeChanges(result.Changes, s.diffAnnotationPath()))
+	}
+
+	return msg
+}
+
+// errUnknownStrategy is returned when the syncer has an unknown Strategy set.
+var errUnknownStrategy = errors.New("unknown sync strategy")
+
+// createOrUpdate persists the subject using the configured strategy.
+func (s *ObjectSyncer) createOrUpdate(ctx context.Context) (controllerutil.OperationResult, error) {
+	switch s.Strategy {
+	case ServerSideApplyStrategy:
+		return s.apply(ctx)
+	case CreateOrPatchStrategy:
+		return s.createOrPatch(ctx)
+	case CreateOrUpdateStrategy:
+		return controllerutil.CreateOrUpdate(ctx, clientFor(ctx, retryClient(ctx, s.Client, s.APIReader)), s.Obj, s.mutateFn())
+	default:
+		return controllerutil.OperationResultNone, fmt.Errorf("%w: %q", errUnknownStrategy, s.Strategy)
+	}
+}
+
 // Given an ObjectSyncer, returns a controllerutil.MutateFn which also sets the
 // owner reference if the subject has one.
 func (s *ObjectSyncer) mutateFn() controllerutil.MutateFn {
 	return func() error {
 		s.previousObject = s.Obj.DeepCopyObject()
 
+		if isPaused(s.Obj) {
+			return errPaused
+		}
+
+		if observeOnly(s.Obj) {
+			return errObserveOnly
+		}
+
+		if s.Recreate != nil && !s.Obj.GetDeletionTimestamp().IsZero() {
+			return errRecreating
+		}
+
 		err := s.SyncFn()
 		if err != nil {
 			return err
 		}
 
-		if s.Owner == nil {
-			return nil
+		ctime := s.Obj.GetCreationTimestamp()
+
+		if err := setOwnerReference(s.Ownership, s.Owner, s.Obj, !ctime.IsZero(), s.Client.Scheme()); err != nil {
+			return err
 		}
 
-		// set owner reference only if owner resource is not being deleted, otherwise the owner
-		// reference will be reset in case of deleting with cascade=false.
-		if s.Owner.GetDeletionTimestamp().IsZero() {
-			if err := controllerutil.SetControllerReference(s.Owner, s.Obj, s.Client.Scheme()); err != nil {
-				return err
-			}
-		} else if ctime := s.Obj.GetCreationTimestamp(); ctime.IsZero() {
-			// the owner is deleted, don't recreate the resource if does not exist, because gc
-			// will not delete it again because has no owner reference set
-			return ErrOwnerDeleted
+		if s.DiffAnnotation != "" && s.Obj.GetResourceVersion() != "" {
+			s.annotateChanges()
 		}
 
 		return nil
 	}
 }
 
+// redact removes sensitive data from the object using the DefaultRedactor and
+// then the syncer's Redactor, so custom redactors only add rules.
+func (s *ObjectSyncer) redact(obj runtime.Object) runtime.Object {
+	obj = DefaultRedactor.Redact(obj, s.Client.Scheme())
+	if s.Redactor == nil || s.Redactor == DefaultRedactor {
+		return obj
+	}
+
+	return s.Redactor.Redact(obj, s.Client.Scheme())
+}
+
+// diffAnnotationPath returns the field path of the DiffAnnotation.
+func (s *ObjectSyncer) diffAnnotationPath() string {
+	if s.DiffAnnotation == "" {
+		return ""
+	}
+
+	return fieldPath("metadata.annotations", s.DiffAnnotation)
+}
+
+// annotateChanges records the paths of the fields changed by the SyncFn in
+// the DiffAnnotation. The annotation is left untouched when nothing changed.
+func (s *ObjectSyncer) annotateChanges() {
+	paths := changedPaths(fieldChanges(s.redact(s.previousObject), s.redact(s.Obj)), s.diffAnnotationPath())
+	if len(paths) == 0 {
+		return
+	}
+
+	annotations := s.Obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations[s.DiffAnnotation] = strings.Join(paths, ",")
+	s.Obj.SetAnnotations(annotations)
+}
+
 // NewObjectSyncer creates a new kubernetes.Object syncer for a given object
 // with an owner and persists data using controller-runtime's CreateOrUpdate.
 // The name is used for logging and event emitting purposes and should be an
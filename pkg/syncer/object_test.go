@@ -29,6 +29,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"github.com/presslabs/controller-util/pkg/syncer"
 )
@@ -128,6 +130,204 @@ var _ = Describe("ObjectSyncer", func() {
 			Expect(syncer.Sync(context.TODO(), syn, recorder)).To(Succeed())
 		})
 
+		It("only reports the operation in dry-run mode", func() {
+			ctx := syncer.WithDryRun(context.TODO())
+
+			result, err := NewDeploymentSyncer(owner, key).Sync(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Operation).To(Equal(controllerutil.OperationResultCreated))
+			Expect(result.Diff).NotTo(BeEmpty())
+
+			Expect(syncer.Sync(ctx, NewDeploymentSyncer(owner, key), recorder)).To(Succeed())
+			Expect(c.Get(context.TODO(), key, deployment)).NotTo(Succeed())
+			Consistently(recorder.Events).ShouldNot(Receive())
+		})
+
+		It("reports the changed fields", func() {
+			var convOk bool
+
+			Expect(syncer.Sync(context.TODO(), NewDeploymentSyncer(owner, key), recorder)).To(Succeed())
+			Expect(recorder.Events).To(Receive())
+
+			objSyncer, convOk = NewDeploymentSyncer(owner, key).(*syncer.ObjectSyncer)
+			Expect(convOk).To(BeTrue())
+
+			syncFn := objSyncer.SyncFn
+			objSyncer.DiffInEvent = true
+			objSyncer.DiffAnnotation = "example.com/last-changes"
+			objSyncer.SyncFn = func() error {
+				objSyncer.Obj.SetLabels(map[string]string{"version": "2"})
+
+				return syncFn()
+			}
+
+			Expect(syncer.Sync(context.TODO(), objSyncer, recorder)).To(Succeed())
+
+			var event string
+			Expect(recorder.Events).To(Receive(&event))
+			Expect(event).To(HaveSuffix("updated successfully (changed metadata.labels.version)"))
+
+			Expect(c.Get(context.TODO(), key, deployment)).To(Succeed())
+			Expect(deployment.Annotations).To(HaveKeyWithValue("example.com/last-changes", "metadata.labels.version"))
+		})
+
+		It("retries on conflicts when ConflictRetry is set", func() {
+			var convOk bool
+
+			Expect(syncer.Sync(context.TODO(), NewDeploymentSyncer(owner, key), recorder)).To(Succeed())
+			Expect(recorder.Events).To(Receive())
+
+			objSyncer, convOk = NewDeploymentSyncer(owner, key).(*syncer.ObjectSyncer)
+			Expect(convOk).To(BeTrue())
+
+			calls := 0
+			syncFn := objSyncer.SyncFn
+			objSyncer.ConflictRetry = &retry.DefaultRetry
+			objSyncer.SyncFn = func() error {
+				calls++
+				if calls == 1 {
+					// update the deployment behind the syncer's back
+					other := &appsv1.Deployment{}
+					Expect(c.Get(context.TODO(), key, other)).To(Succeed())
+					other.Labels = map[string]string{"changed": "true"}
+					Expect(c.Update(context.TODO(), other)).To(Succeed())
+				}
+
+				objSyncer.Obj.SetAnnotations(map[string]string{"synced": "true"})
+
+				return syncFn()
+			}
+
+			result, err := objSyncer.Sync(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Attempts).To(Equal(2))
+
+			Expect(c.Get(context.TODO(), key, deployment)).To(Succeed())
+			Expect(deployment.Labels).To(HaveKeyWithValue("changed", "true"))
+			Expect(deployment.Annotations).To(HaveKeyWithValue("synced", "true"))
+		})
+
+		It("recreates the object when immutable fields change and Recreate is set", func() {
+			Expect(syncer.Sync(context.TODO(), NewDeploymentSyncer(owner, key), recorder)).To(Succeed())
+			Expect(recorder.Events).To(Receive())
+			Expect(c.Get(context.TODO(), key, deployment)).To(Succeed())
+			uid := deployment.UID
+
+			newSelectorSyncer := func() *syncer.ObjectSyncer {
+				objSyncer, convOk := NewDeploymentSyncer(owner, key).(*syncer.ObjectSyncer)
+				Expect(convOk).To(BeTrue())
+
+				syncFn := objSyncer.SyncFn
+				objSyncer.SyncFn = func() error {
+					if err := syncFn(); err != nil {
+						return err
+					}
+
+					depl, ok := objSyncer.Obj.(*appsv1.Deployment)
+					Expect(ok).To(BeTrue())
+
+					depl.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "baz"}}
+					depl.Spec.Template.Labels = map[string]string{"foo": "baz"}
+
+					return nil
+				}
+
+				return objSyncer
+			}
+
+			Expect(syncer.Sync(context.TODO(), newSelectorSyncer(), recorder)).NotTo(Succeed())
+			Expect(recorder.Events).To(Receive(ContainSubstring("field is immutable")))
+
+			background := metav1.DeletePropagationBackground
+			objSyncer = newSelectorSyncer()
+			objSyncer.Recreate = &syncer.RecreatePolicy{PropagationPolicy: &background}
+
+			result, err := objSyncer.Sync(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Operation).To(Equal(syncer.OperationResultRecreated))
+
+			Expect(c.Get(context.TODO(), key, deployment)).To(Succeed())
+			Expect(deployment.UID).NotTo(Equal(uid))
+			Expect(deployment.Spec.Selector.MatchLabels).To(HaveKeyWithValue("foo", "baz"))
+		})
+
+		It("reports the drift without persisting the object in observe-only mode", func() {
+			Expect(syncer.Sync(context.TODO(), NewDeploymentSyncer(owner, key), recorder)).To(Succeed())
+			Expect(recorder.Events).To(Receive())
+
+			// change the deployment manually
+			Expect(c.Get(context.TODO(), key, deployment)).To(Succeed())
+			deployment.Spec.Template.Spec.Containers[0].Image = "busybox:manual"
+			Expect(c.Update(context.TODO(), deployment)).To(Succeed())
+
+			var convOk bool
+
+			objSyncer, convOk = NewDeploymentSyncer(owner, key).(*syncer.ObjectSyncer)
+			Expect(convOk).To(BeTrue())
+
+			objSyncer.ObserveOnly = true
+
+			result, err := objSyncer.Sync(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Operation).To(Equal(syncer.OperationResultDrifted))
+			Expect(result.Changes).To(ContainElement(HaveField("Path", "spec.template.spec.containers[0].image")))
+
+			Expect(c.Get(context.TODO(), key, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(Equal("busybox:manual"))
+
+			// the annotation puts the object in observe-only mode too
+			deployment.Annotations = map[string]string{syncer.ObserveOnlyAnnotation: "true"}
+			Expect(c.Update(context.TODO(), deployment)).To(Succeed())
+
+			Expect(syncer.Sync(context.TODO(), NewDeploymentSyncer(owner, key), recorder)).To(Succeed())
+			Expect(recorder.Events).To(Receive(HavePrefix("Warning ExampleDeploymentDrifted")))
+
+			Expect(c.Get(context.TODO(), key, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(Equal("busybox:manual"))
+		})
+
+		It("does not persist the object while it or its owner are paused", func() {
+			Expect(syncer.Sync(context.TODO(), NewDeploymentSyncer(owner, key), recorder)).To(Succeed())
+			Expect(recorder.Events).To(Receive())
+
+			// change the deployment manually and pause it
+			Expect(c.Get(context.TODO(), key, deployment)).To(Succeed())
+			deployment.Annotations = map[string]string{syncer.PauseAnnotation: "true"}
+			deployment.Spec.Template.Spec.Containers[0].Image = "busybox:manual"
+			Expect(c.Update(context.TODO(), deployment)).To(Succeed())
+
+			result, err := NewDeploymentSyncer(owner, key).Sync(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Operation).To(Equal(syncer.OperationResultPaused))
+
+			Expect(syncer.Sync(context.TODO(), NewDeploymentSyncer(owner, key), recorder)).To(Succeed())
+			Expect(recorder.Events).To(Receive(HavePrefix("Normal ExampleDeploymentPaused")))
+			Consistently(recorder.Events).ShouldNot(Receive())
+
+			Expect(c.Get(context.TODO(), key, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(Equal("busybox:manual"))
+
+			// pausing the owner pauses the syncer too
+			delete(deployment.Annotations, syncer.PauseAnnotation)
+			Expect(c.Update(context.TODO(), deployment)).To(Succeed())
+
+			owner.Annotations = map[string]string{syncer.PauseAnnotation: "true"}
+
+			result, err = NewDeploymentSyncer(owner, key).Sync(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Operation).To(Equal(syncer.OperationResultPaused))
+
+			Expect(c.Get(context.TODO(), key, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(Equal("busybox:manual"))
+
+			// resuming reverts the manual changes
+			owner.Annotations = nil
+
+			result, err = NewDeploymentSyncer(owner, key).Sync(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Operation).To(Equal(controllerutil.OperationResultUpdated))
+		})
+
 		When("owner is deleted", func() {
 			BeforeEach(func() {
 				// set deletion timestamp on owner resource
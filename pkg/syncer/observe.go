@@ -0,0 +1,95 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iancoleman/strcase"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// OperationResultDrifted is the result of an ObjectSyncer in observe-only mode
+// when the persisted object differs from the desired state.
+const OperationResultDrifted controllerutil.OperationResult = "drifted"
+
+// ObserveOnlyAnnotation puts the ObjectSyncer of the annotated object in
+// observe-only mode when set to "true" (see ObjectSyncer.ObserveOnly).
+const ObserveOnlyAnnotation = "controller-util.presslabs.com/observe-only"
+
+// errObserveOnly is returned by the mutate function of an ObjectSyncer when
+// the persisted object has the ObserveOnlyAnnotation.
+var errObserveOnly = errors.New("object is in observe-only mode")
+
+// observeOnly returns true if the object has the ObserveOnlyAnnotation set.
+func observeOnly(obj client.Object) bool {
+	return obj.GetAnnotations()[ObserveOnlyAnnotation] == "true"
+}
+
+// observe runs the SyncFn on the persisted object, without persisting it, and
+// reports whether it drifted from the desired state.
+func (s *ObjectSyncer) observe(ctx context.Context) (controllerutil.OperationResult, error) {
+	key := client.ObjectKeyFromObject(s.Obj)
+	exists := true
+
+	if err := retryClient(ctx, s.Client, s.APIReader).Get(ctx, key, s.Obj); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return controllerutil.OperationResultNone, err
+		}
+
+		exists = false
+	}
+
+	live := deepCopy(s.Obj)
+	s.previousObject = live
+
+	if exists && isPaused(live) {
+		return OperationResultPaused, nil
+	}
+
+	if err := s.SyncFn(); err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	if err := setOwnerReference(s.Ownership, s.Owner, s.Obj, exists, s.Client.Scheme()); err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	if !exists || changed(live, s.Obj) {
+		return OperationResultDrifted, nil
+	}
+
+	return controllerutil.OperationResultNone, nil
+}
+
+// driftMessage returns the event message for an object which drifted.
+func (s *ObjectSyncer) driftMessage(key client.ObjectKey, result SyncResult) string {
+	msg := fmt.Sprintf("%s %s drifted from the desired state", objectType(s.Obj, s.Client), key)
+	if len(result.Changes) > 0 {
+		msg += fmt.Sprintf(" (changed %s)", describeChanges(result.Changes, s.diffAnnotationPath()))
+	}
+
+	return msg
+}
+
+func driftEventReason(name string) string {
+	return strcase.ToCamel(name) + "Drifted"
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// OwnershipPolicy selects how an ObjectSyncer records the owner on its subject.
+type OwnershipPolicy string
+
+const (
+	// ControllerOwnership sets the owner as the controller of the object,
+	// using an owner reference. This is the default policy.
+	ControllerOwnership OwnershipPolicy = ""
+
+	// OwnerReferenceOwnership adds a plain, non-controller, owner reference
+	// to the object, so the object can have multiple owners.
+	OwnerReferenceOwnership OwnershipPolicy = "OwnerReference"
+
+	// LabelOwnership records the owner using the OwnerNameLabel,
+	// OwnerNamespaceLabel and OwnerKindLabel labels. Unlike owner references
+	// it works for cluster-scoped objects and for objects in other namespaces
+	// than the owner, but the objects are not garbage collected. Use
+	// EnqueueRequestForLabelOwner to watch them. Owner names longer than a
+	// label value allows are shortened using a hash and recorded in full in
+	// the OwnerNameAnnotation.
+	LabelOwnership OwnershipPolicy = "Labels"
+
+	// NoOwnership leaves the object without an owner.
+	NoOwnership OwnershipPolicy = "None"
+)
+
+const (
+	// OwnerNameLabel is the label holding the owner name, set by the LabelOwnership policy.
+	OwnerNameLabel = "controller-util.presslabs.com/owner-name"
+	// OwnerNamespaceLabel is the label holding the owner namespace, set by the LabelOwnership policy.
+	OwnerNamespaceLabel = "controller-util.presslabs.com/owner-namespace"
+	// OwnerKindLabel is the label holding the owner group kind (eg. Deployment.apps),
+	// set by the LabelOwnership policy.
+	OwnerKindLabel = "controller-util.presslabs.com/owner-kind"
+
+	// OwnerNameAnnotation is the annotation holding the full owner name, set by
+	// the LabelOwnership policy when the name doesn't fit in the OwnerNameLabel.
+	OwnerNameAnnotation = "controller-util.presslabs.com/owner-name"
+)
+
+// ownerNameHashLen is the number of hex characters of the hash suffix of the
+// shortened owner names.
+const ownerNameHashLen = 10
+
+// errUnknownOwnership is returned for unknown ownership policies.
+var errUnknownOwnership = errors.New("unknown ownership policy")
+
+// setOwnerReference records the owner on the object according to the policy.
+// The exists flag tells whether the object is already persisted.
+func setOwnerReference(policy OwnershipPolicy, owner, obj client.Object, exists bool, scheme *runtime.Scheme) error {
+	if owner == nil {
+		return nil
+	}
+
+	// set owner reference only if owner resource is not being deleted, otherwise the owner
+	// reference will be reset in case of deleting with cascade=false.
+	if !owner.GetDeletionTimestamp().IsZero() {
+		if !exists {
+			// the owner is deleted, don't recreate the resource if does not exist, because gc
+			// will not delete it again because has no owner reference set
+			return ErrOwnerDeleted
+		}
+
+		return nil
+	}
+
+	switch policy {
+	case ControllerOwnership:
+		return controllerutil.SetControllerReference(owner, obj, scheme)
+	case OwnerReferenceOwnership:
+		return controllerutil.SetOwnerReference(owner, obj, scheme)
+	case LabelOwnership:
+		return setOwnerLabels(owner, obj, scheme)
+	case NoOwnership:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", errUnknownOwnership, policy)
+	}
+}
+
+// setOwnerLabels sets the labels which record the owner on the object.
+func setOwnerLabels(owner, obj client.Object, scheme *runtime.Scheme) error {
+	gvk, err := apiutil.GVKForObject(owner, scheme)
+	if err != nil {
+		return err
+	}
+
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	labels[OwnerNameLabel] = ownerNameLabelValue(owner.GetName())
+	labels[OwnerKindLabel] = gvk.GroupKind().String()
+
+	if owner.GetNamespace() != "" {
+		labels[OwnerNamespaceLabel] = owner.GetNamespace()
+	} else {
+		delete(labels, OwnerNamespaceLabel)
+	}
+
+	obj.SetLabels(labels)
+
+	annotations := obj.GetAnnotations()
+	if labels[OwnerNameLabel] != owner.GetName() {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+
+		annotations[OwnerNameAnnotation] = owner.GetName()
+		obj.SetAnnotations(annotations)
+	} else if _, ok := annotations[OwnerNameAnnotation]; ok {
+		delete(annotations, OwnerNameAnnotation)
+		obj.SetAnnotations(annotations)
+	}
+
+	return nil
+}
+
+// ownerNameLabelValue returns the owner name, shortened to a valid label
+// value if needed. The shortened names keep a prefix of the name and end with
+// a hash of the full name, so they don't collide.
+func ownerNameLabelValue(name string) string {
+	if len(name) <= validation.LabelValueMaxLength {
+		return name
+	}
+
+	sum := sha256.Sum256([]byte(name))
+	prefix := strings.TrimRight(name[:validation.LabelValueMaxLength-ownerNameHashLen-1], "-.")
+
+	return prefix + "-" + hex.EncodeToString(sum[:])[:ownerNameHashLen]
+}
+
+// EnqueueRequestForLabelOwner returns an event handler which enqueues a
+// request for the owner of the objects synced with the LabelOwnership policy,
+// when the owner is of the same kind as ownerType. The full owner name is
+// taken from the OwnerNameAnnotation when the label holds a shortened name.
+func EnqueueRequestForLabelOwner(scheme *runtime.Scheme, ownerType client.Object) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		gvk, err := apiutil.GVKForObject(ownerType, scheme)
+		if err != nil {
+			logf.FromContext(ctx).Error(err, "failed getting the owner kind", "type", fmt.Sprintf("%T", ownerType))
+
+			return nil
+		}
+
+		labels := obj.GetLabels()
+		if labels[OwnerKindLabel] != gvk.GroupKind().String() || labels[OwnerNameLabel] == "" {
+			return nil
+		}
+
+		name := labels[OwnerNameLabel]
+		if full := obj.GetAnnotations()[OwnerNameAnnotation]; full != "" && ownerNameLabelValue(full) == name {
+			name = full
+		}
+
+		return []reconcile.Request{{
+			NamespacedName: types.NamespacedName{
+				Name:      name,
+				Namespace: labels[OwnerNamespaceLabel],
+			},
+		}}
+	})
+}
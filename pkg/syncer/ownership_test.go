@@ -0,0 +1,119 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("OwnershipPolicy", func() {
+	var (
+		owner *appsv1.Deployment
+		obj   *corev1.Namespace
+	)
+
+	BeforeEach(func() {
+		owner = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: "default", UID: "owner-uid"}}
+		obj = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "child"}}
+	})
+
+	It("sets the owner as controller by default", func() {
+		Expect(setOwnerReference(ControllerOwnership, owner, obj, false, clientgoscheme.Scheme)).
+			To(MatchError(ContainSubstring("cluster-scoped resource must not have a namespace-scoped owner")))
+
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "child", Namespace: "default"}}
+		Expect(setOwnerReference(ControllerOwnership, owner, cm, false, clientgoscheme.Scheme)).To(Succeed())
+		Expect(cm.OwnerReferences).To(ConsistOf(HaveField("Controller", ptr.To(true))))
+	})
+
+	It("sets a plain owner reference", func() {
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "child", Namespace: "default"}}
+		Expect(setOwnerReference(OwnerReferenceOwnership, owner, cm, false, clientgoscheme.Scheme)).To(Succeed())
+		Expect(cm.OwnerReferences).To(HaveLen(1))
+		Expect(cm.OwnerReferences[0].Controller).To(BeNil())
+	})
+
+	It("leaves the object without owner", func() {
+		Expect(setOwnerReference(NoOwnership, owner, obj, false, clientgoscheme.Scheme)).To(Succeed())
+		Expect(obj.OwnerReferences).To(BeEmpty())
+		Expect(obj.Labels).To(BeEmpty())
+	})
+
+	It("records the owner using labels and maps them back to the owner", func() {
+		Expect(setOwnerReference(LabelOwnership, owner, obj, false, clientgoscheme.Scheme)).To(Succeed())
+		Expect(obj.OwnerReferences).To(BeEmpty())
+		Expect(obj.Labels).To(Equal(map[string]string{
+			OwnerNameLabel:      "owner",
+			OwnerNamespaceLabel: "default",
+			OwnerKindLabel:      "Deployment.apps",
+		}))
+
+		queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+		defer queue.ShutDown()
+
+		EnqueueRequestForLabelOwner(clientgoscheme.Scheme, &appsv1.StatefulSet{}).Create(context.TODO(), event.CreateEvent{Object: obj}, queue)
+		Expect(queue.Len()).To(Equal(0))
+
+		EnqueueRequestForLabelOwner(clientgoscheme.Scheme, &appsv1.Deployment{}).Create(context.TODO(), event.CreateEvent{Object: obj}, queue)
+		Expect(queue.Len()).To(Equal(1))
+
+		req, _ := queue.Get()
+		Expect(req.NamespacedName).To(Equal(types.NamespacedName{Name: "owner", Namespace: "default"}))
+	})
+
+	It("shortens long owner names to valid label values", func() {
+		owner.Name = strings.Repeat("long-owner-name.", 10) + "x"
+		Expect(setOwnerReference(LabelOwnership, owner, obj, false, clientgoscheme.Scheme)).To(Succeed())
+		Expect(validation.IsValidLabelValue(obj.Labels[OwnerNameLabel])).To(BeEmpty())
+		Expect(obj.Labels[OwnerNameLabel]).To(HavePrefix("long-owner-name."))
+		Expect(obj.Annotations).To(HaveKeyWithValue(OwnerNameAnnotation, owner.Name))
+
+		queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+		defer queue.ShutDown()
+
+		EnqueueRequestForLabelOwner(clientgoscheme.Scheme, &appsv1.Deployment{}).Create(context.TODO(), event.CreateEvent{Object: obj}, queue)
+		req, _ := queue.Get()
+		Expect(req.NamespacedName).To(Equal(types.NamespacedName{Name: owner.Name, Namespace: "default"}))
+
+		owner.Name = "owner"
+		Expect(setOwnerReference(LabelOwnership, owner, obj, true, clientgoscheme.Scheme)).To(Succeed())
+		Expect(obj.Labels).To(HaveKeyWithValue(OwnerNameLabel, "owner"))
+		Expect(obj.Annotations).NotTo(HaveKey(OwnerNameAnnotation))
+	})
+
+	It("does not create objects for deleted owners", func() {
+		now := metav1.Now()
+		owner.DeletionTimestamp = &now
+
+		Expect(setOwnerReference(LabelOwnership, owner, obj, false, clientgoscheme.Scheme)).To(MatchError(ErrOwnerDeleted))
+	})
+})
@@ -0,0 +1,179 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// errKeyMutated is returned when the SyncFn changes the name or the namespace of the object.
+var errKeyMutated = errors.New("SyncFn cannot mutate object name and/or object namespace")
+
+// createOrPatch persists the subject by patching only the fields changed by
+// the SyncFn. The object and its status subresource are patched separately
+// and only when they differ from the persisted state.
+func (s *ObjectSyncer) createOrPatch(ctx context.Context) (controllerutil.OperationResult, error) {
+	key := client.ObjectKeyFromObject(s.Obj)
+
+	if err := retryClient(ctx, s.Client, s.APIReader).Get(ctx, key, s.Obj); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return controllerutil.OperationResultNone, err
+		}
+
+		if err := s.mutate(key); err != nil {
+			return controllerutil.OperationResultNone, err
+		}
+
+		if err := clientFor(ctx, s.Client).Create(ctx, s.Obj); err != nil {
+			return controllerutil.OperationResultNone, err
+		}
+
+		return controllerutil.OperationResultCreated, nil
+	}
+
+	before := deepCopy(s.Obj)
+
+	if err := s.mutate(key); err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	return s.patchChanges(ctx, before)
+}
+
+// patchChanges sends the changes between before and the subject as patches.
+func (s *ObjectSyncer) patchChanges(ctx context.Context, before client.Object) (controllerutil.OperationResult, error) {
+	beforeObj, beforeStatus, err := splitStatus(before)
+	if err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	afterObj, afterStatus, err := splitStatus(s.Obj)
+	if err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	result := controllerutil.OperationResultNone
+
+	if !reflect.DeepEqual(beforeObj, afterObj) {
+		if err := clientFor(ctx, s.Client).Patch(ctx, s.Obj, patchFrom(before)); err != nil {
+			return result, err
+		}
+
+		result = controllerutil.OperationResultUpdated
+	}
+
+	if reflect.DeepEqual(beforeStatus, afterStatus) {
+		return result, nil
+	}
+
+	if result == controllerutil.OperationResultUpdated {
+		// the patch response reset the status to the persisted one
+		if err := setStatus(s.Obj, afterStatus); err != nil {
+			return result, err
+		}
+	}
+
+	if err := clientFor(ctx, s.Client).Status().Patch(ctx, s.Obj, patchFrom(before)); err != nil {
+		return result, err
+	}
+
+	if result == controllerutil.OperationResultUpdated {
+		return controllerutil.OperationResultUpdatedStatus, nil
+	}
+
+	return controllerutil.OperationResultUpdatedStatusOnly, nil
+}
+
+// strategicMergePackages are the packages of the go types served by the
+// kubernetes API server, which support strategic merge patches.
+var strategicMergePackages = []string{
+	"k8s.io/api/",
+	"k8s.io/apiextensions-apiserver/",
+	"k8s.io/kube-aggregator/",
+}
+
+// patchFrom returns a strategic merge patch for the kubernetes builtin types
+// and a JSON merge patch for everything else (eg. custom resources), which
+// don't support strategic merge. The builtin types are told apart by their go
+// package, as custom resources are often registered in the same scheme.
+func patchFrom(obj client.Object) client.Patch {
+	t := reflect.TypeOf(obj)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	for _, pkg := range strategicMergePackages {
+		if strings.HasPrefix(t.PkgPath(), pkg) {
+			return client.StrategicMergeFrom(obj)
+		}
+	}
+
+	return client.MergeFrom(obj)
+}
+
+// mutate calls the SyncFn and ensures it didn't change the object key.
+func (s *ObjectSyncer) mutate(key client.ObjectKey) error {
+	if err := s.mutateFn()(); err != nil {
+		return err
+	}
+
+	if client.ObjectKeyFromObject(s.Obj) != key {
+		return errKeyMutated
+	}
+
+	return nil
+}
+
+// splitStatus converts the object to unstructured data and returns it without
+// the status, alongside the status.
+func splitStatus(obj client.Object) (map[string]interface{}, interface{}, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj.DeepCopyObject())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := u["status"]
+	delete(u, "status")
+
+	return u, status, nil
+}
+
+// setStatus sets the status of the object from unstructured data.
+func setStatus(obj client.Object, status interface{}) error {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return err
+	}
+
+	u["status"] = status
+
+	if unstr, ok := obj.(runtime.Unstructured); ok {
+		unstr.SetUnstructuredContent(u)
+
+		return nil
+	}
+
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u, obj)
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// exampleResource is a custom resource type, which doesn't support strategic merge patches.
+type exampleResource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+}
+
+func (r *exampleResource) DeepCopyObject() runtime.Object {
+	out := *r
+	r.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+
+	return &out
+}
+
+var _ = Describe("patchFrom", func() {
+	It("uses strategic merge patches for the kubernetes builtin types", func() {
+		Expect(patchFrom(&corev1.ConfigMap{}).Type()).To(Equal(types.StrategicMergePatchType))
+	})
+
+	It("uses JSON merge patches for unstructured objects and custom resources", func() {
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion("v1")
+		u.SetKind("ConfigMap")
+
+		Expect(patchFrom(u).Type()).To(Equal(types.MergePatchType))
+		Expect(patchFrom(&exampleResource{}).Type()).To(Equal(types.MergePatchType))
+	})
+})
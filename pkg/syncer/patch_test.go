@@ -0,0 +1,121 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/presslabs/controller-util/pkg/syncer"
+)
+
+var _ = Describe("ObjectSyncer with CreateOrPatchStrategy", func() {
+	var (
+		owner    *corev1.ConfigMap
+		key      types.NamespacedName
+		image    string
+		replicas int32
+	)
+
+	newSyncer := func() syncer.Interface {
+		objSyncer, convOk := NewDeploymentSyncer(owner, key).(*syncer.ObjectSyncer)
+		Expect(convOk).To(BeTrue())
+
+		deploy, convOk := objSyncer.Obj.(*appsv1.Deployment)
+		Expect(convOk).To(BeTrue())
+
+		syncFn := objSyncer.SyncFn
+		objSyncer.Strategy = syncer.CreateOrPatchStrategy
+		objSyncer.SyncFn = func() error {
+			if err := syncFn(); err != nil {
+				return err
+			}
+
+			deploy.Spec.Template.Spec.Containers[0].Image = image
+			deploy.Status.Replicas = replicas
+
+			return nil
+		}
+
+		return objSyncer
+	}
+
+	BeforeEach(func() {
+		r := rand.Int31() //nolint: gosec
+
+		key = types.NamespacedName{
+			Name:      fmt.Sprintf("example-%d", r),
+			Namespace: fmt.Sprintf("default-%d", r),
+		}
+		image = "busybox"
+		replicas = 0
+		owner = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+		}
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: key.Namespace,
+			},
+		}
+		Expect(c.Create(context.TODO(), ns)).To(Succeed())
+		Expect(c.Create(context.TODO(), owner)).To(Succeed())
+	})
+
+	It("reports the patched parts of the object", func() {
+		result, err := newSyncer().Sync(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Operation).To(Equal(controllerutil.OperationResultCreated))
+
+		result, err = newSyncer().Sync(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Operation).To(Equal(controllerutil.OperationResultNone))
+
+		image = "busybox:latest"
+		result, err = newSyncer().Sync(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Operation).To(Equal(controllerutil.OperationResultUpdated))
+
+		replicas = 1
+		result, err = newSyncer().Sync(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Operation).To(Equal(controllerutil.OperationResultUpdatedStatusOnly))
+
+		image = "busybox:stable"
+		replicas = 2
+		result, err = newSyncer().Sync(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Operation).To(Equal(controllerutil.OperationResultUpdatedStatus))
+
+		deployment := &appsv1.Deployment{}
+		Expect(c.Get(context.TODO(), key, deployment)).To(Succeed())
+		Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(Equal("busybox:stable"))
+		Expect(deployment.Status.Replicas).To(Equal(int32(2)))
+	})
+})
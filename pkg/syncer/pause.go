@@ -0,0 +1,103 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/iancoleman/strcase"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// OperationResultPaused is the result of a syncer whose subject or owner has
+// the PauseAnnotation set.
+const OperationResultPaused controllerutil.OperationResult = "paused"
+
+// PauseAnnotation pauses the syncers of the annotated object, and the syncers
+// owned by the annotated object, when set to "true". Paused syncers don't
+// write anything, so the objects can be edited by hand (eg. during an
+// incident) without the controller reverting the changes. It can be changed
+// at startup for using a controller specific annotation. The paused event is
+// recorded on every sync while the syncer is paused, unless the events are
+// recorded using a PolicyRecorder with SuppressRepeated set, which records it
+// once, when the syncer becomes paused.
+var PauseAnnotation = "controller-util.presslabs.com/paused"
+
+// errPaused is returned by the mutate function of an ObjectSyncer when the
+// persisted object has the PauseAnnotation.
+var errPaused = errors.New("sync is paused")
+
+// isPaused returns true if the object is a kubernetes object which has the
+// PauseAnnotation set.
+func isPaused(obj interface{}) bool {
+	if v := reflect.ValueOf(obj); !v.IsValid() || (v.Kind() == reflect.Ptr && v.IsNil()) {
+		return false
+	}
+
+	accessor, err := apimeta.Accessor(obj)
+	if err != nil {
+		return false
+	}
+
+	return accessor.GetAnnotations()[PauseAnnotation] == "true"
+}
+
+// setPaused marks the result as paused, with its event, and logs the skip.
+func setPaused(ctx context.Context, result *SyncResult, name, subject string) {
+	result.Operation = OperationResultPaused
+	result.SetEventData(eventNormal, pausedEventReason(name),
+		fmt.Sprintf("%s sync is paused by the %s annotation", subject, PauseAnnotation))
+
+	logf.FromContext(ctx, "syncer", name).Info("syncer paused", "subject", subject, "annotation", PauseAnnotation)
+}
+
+func pausedEventReason(name string) string {
+	return strcase.ToCamel(name) + "Paused"
+}
+
+// pauseTracker is implemented by the event sinks which record the paused event
+// of a syncer only when it becomes paused, and not on every sync while it
+// stays paused.
+type pauseTracker interface {
+	// pauseChanged tracks whether the syncer identified by the key is paused
+	// and returns false when the result is the paused event of a syncer which
+	// was already paused, so it must not be recorded again.
+	pauseChanged(key string, result SyncResult) bool
+}
+
+// pausedKey identifies a syncer by its owner, its type and its subject, as
+// syncers are usually created for every sync.
+func pausedKey(syncer Interface, owner runtime.Object) string {
+	return fmt.Sprintf("%s %T %s", objectID(owner), syncer, subjectID(syncer.Object()))
+}
+
+// subjectID returns an identifier of a syncer subject, which is the same for
+// the subjects of the syncers created for each sync.
+func subjectID(subject interface{}) string {
+	if obj, ok := subject.(client.Object); ok && !reflect.ValueOf(obj).IsNil() {
+		return fmt.Sprintf("%T %s/%s", obj, obj.GetNamespace(), obj.GetName())
+	}
+
+	return fmt.Sprintf("%T", subject)
+}
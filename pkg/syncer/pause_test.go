@@ -0,0 +1,102 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+var _ = Describe("Paused syncers", func() {
+	var (
+		events   *record.FakeRecorder
+		recorder *PolicyRecorder
+		now      time.Time
+		owner    *corev1.ConfigMap
+	)
+
+	newSyncer := func() Interface {
+		return NewExternalSyncer("ExampleRepo", owner, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "child", Namespace: "default"},
+		}, func(context.Context, interface{}) (controllerutil.OperationResult, error) {
+			return controllerutil.OperationResultUpdated, nil
+		})
+	}
+
+	BeforeEach(func() {
+		now = time.Now()
+		events = record.NewFakeRecorder(100)
+		recorder = NewPolicyRecorder(events, EventPolicy{SuppressRepeated: true})
+		recorder.now = func() time.Time { return now }
+		owner = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:        "owner",
+			Namespace:   "default",
+			UID:         uuid.NewUUID(),
+			Annotations: map[string]string{PauseAnnotation: "true"},
+		}}
+	})
+
+	It("records the paused event on every sync without a PolicyRecorder", func() {
+		Expect(Sync(context.TODO(), newSyncer(), events)).To(Succeed())
+		Expect(Sync(context.TODO(), newSyncer(), events)).To(Succeed())
+
+		Expect(events.Events).To(HaveLen(2))
+	})
+
+	It("records the paused event once, when the syncer becomes paused", func() {
+		Expect(Sync(context.TODO(), newSyncer(), recorder)).To(Succeed())
+		Expect(Sync(context.TODO(), newSyncer(), recorder)).To(Succeed())
+
+		Expect(events.Events).To(HaveLen(1))
+		Expect(<-events.Events).To(HavePrefix("Normal ExampleRepoPaused"))
+
+		// resuming and pausing the syncer again records the event again
+		owner.Annotations = nil
+		Expect(Sync(context.TODO(), newSyncer(), recorder)).To(Succeed())
+
+		owner.Annotations = map[string]string{PauseAnnotation: "true"}
+		Expect(Sync(context.TODO(), newSyncer(), recorder)).To(Succeed())
+		Expect(Sync(context.TODO(), newSyncer(), recorder)).To(Succeed())
+
+		Expect(events.Events).To(HaveLen(2))
+		Expect(<-events.Events).To(HavePrefix("Normal ExampleRepoSyncSuccessfull"))
+		Expect(<-events.Events).To(HavePrefix("Normal ExampleRepoPaused"))
+	})
+
+	It("records the paused event again and forgets the syncer after the repeat expiry", func() {
+		Expect(Sync(context.TODO(), newSyncer(), recorder)).To(Succeed())
+		Expect(recorder.paused).To(HaveLen(1))
+
+		now = now.Add(DefaultRepeatExpiry)
+		Expect(Sync(context.TODO(), newSyncer(), recorder)).To(Succeed())
+		Expect(events.Events).To(HaveLen(2))
+
+		// the syncer is gone, eg. its owner was deleted while paused
+		now = now.Add(DefaultRepeatExpiry)
+		recorder.Event(owner, "Normal", "Other", "other event")
+		Expect(recorder.paused).To(BeEmpty())
+	})
+})
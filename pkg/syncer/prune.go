@@ -0,0 +1,232 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// OperationResultPruned is the result of a PruneSyncer which deleted objects.
+const OperationResultPruned controllerutil.OperationResult = "pruned"
+
+// PruneSyncer is a syncer.Interface for deleting the objects controlled by an
+// owner which are no longer produced by a set of syncers. Only the objects
+// having the owner as controller reference (the ControllerOwnership policy)
+// are pruned. The objects synced using the OwnerReferenceOwnership or
+// LabelOwnership policies are left in place.
+type PruneSyncer struct {
+	Owner  client.Object
+	Name   string
+	Client client.Client
+
+	// Kinds are the kinds of objects which are pruned.
+	Kinds []schema.GroupVersionKind
+	// Selector limits pruning to the objects matching it. Defaults to all objects.
+	Selector labels.Selector
+	// Keep are the syncers whose subjects are not pruned.
+	Keep []Interface
+
+	// Pruned holds the objects deleted by the last sync, or the ones which
+	// would have been deleted in dry-run mode.
+	Pruned []client.Object
+}
+
+type prunedKey struct {
+	gk  schema.GroupKind
+	key client.ObjectKey
+}
+
+// Object returns the PruneSyncer subjects, the objects it pruned.
+func (s *PruneSyncer) Object() interface{} {
+	return s.Pruned
+}
+
+// ObjectOwner returns the PruneSyncer owner.
+func (s *PruneSyncer) ObjectOwner() runtime.Object {
+	return s.Owner
+}
+
+// Sync does the actual syncing and implements the syncer.Inteface Sync method.
+func (s *PruneSyncer) Sync(ctx context.Context) (SyncResult, error) {
+	result := SyncResult{}
+	log := logf.FromContext(ctx, "syncer", s.Name)
+
+	start := time.Now()
+	err := s.prune(ctx, &result)
+	observeSync(ctx, s.Name, "prune", result.Operation, err, start)
+
+	if err != nil {
+		result.SetEventData(eventWarning, basicEventReason(s.Name, err),
+			fmt.Sprintf("failed pruning objects: %s", err))
+		log.Error(err, "failed pruning objects", "pruned", len(s.Pruned))
+
+		return result, err
+	}
+
+	if result.Operation == OperationResultPaused {
+		setPaused(ctx, &result, s.Name, "prune")
+
+		return result, nil
+	}
+
+	log.V(1).Info(string(result.Operation), "pruned", len(s.Pruned))
+
+	return result, nil
+}
+
+func (s *PruneSyncer) prune(ctx context.Context, result *SyncResult) error {
+	log := logf.FromContext(ctx, "syncer", s.Name)
+
+	result.Operation = controllerutil.OperationResultNone
+	s.Pruned = nil
+
+	if isPaused(s.Owner) {
+		result.Operation = OperationResultPaused
+
+		return nil
+	}
+
+	kept := s.keptObjects()
+	errs := []error{}
+
+	for _, gvk := range s.Kinds {
+		objs, err := s.listControlled(ctx, gvk)
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		for _, obj := range objs {
+			key := client.ObjectKeyFromObject(obj)
+			if _, ok := kept[prunedKey{gk: gvk.GroupKind(), key: key}]; ok {
+				continue
+			}
+
+			if isPaused(obj) {
+				log.Info("paused object not pruned", "key", key, "kind", gvk.GroupKind())
+
+				continue
+			}
+
+			if err := clientFor(ctx, s.Client).Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+				errs = append(errs, fmt.Errorf("error when deleting %s %s: %w", gvk.Kind, key, err))
+
+				continue
+			}
+
+			s.Pruned = append(s.Pruned, obj)
+			result.Operation = OperationResultPruned
+			result.Events = append(result.Events, Event{
+				Type:    eventNormal,
+				Reason:  basicEventReason(s.Name, nil),
+				Message: fmt.Sprintf("%s %s pruned successfully", gvk.GroupKind(), key),
+				Related: obj,
+			})
+
+			log.Info("object pruned", "key", key, "kind", gvk.GroupKind())
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// keptObjects returns the keys of the objects synced by the Keep syncers.
+func (s *PruneSyncer) keptObjects() map[prunedKey]struct{} {
+	kept := map[prunedKey]struct{}{}
+
+	for _, syncer := range s.Keep {
+		obj, ok := syncer.Object().(client.Object)
+		if !ok {
+			continue
+		}
+
+		gvk, err := apiutil.GVKForObject(obj, s.Client.Scheme())
+		if err != nil {
+			continue
+		}
+
+		kept[prunedKey{gk: gvk.GroupKind(), key: client.ObjectKeyFromObject(obj)}] = struct{}{}
+	}
+
+	return kept
+}
+
+// listControlled lists the objects of the given kind controlled by the owner,
+// which are not already being deleted.
+func (s *PruneSyncer) listControlled(ctx context.Context, gvk schema.GroupVersionKind) ([]client.Object, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+
+	opts := []client.ListOption{}
+	if s.Owner.GetNamespace() != "" {
+		opts = append(opts, client.InNamespace(s.Owner.GetNamespace()))
+	}
+
+	if s.Selector != nil {
+		opts = append(opts, client.MatchingLabelsSelector{Selector: s.Selector})
+	}
+
+	if err := s.Client.List(ctx, list, opts...); err != nil {
+		if k8serrors.IsNotFound(err) || apimeta.IsNoMatchError(err) {
+			// the kind is not served (eg. its CRD is not installed), so there is nothing to prune
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error when listing %s: %w", gvk.Kind, err)
+	}
+
+	objs := []client.Object{}
+
+	for i := range list.Items {
+		obj := &list.Items[i]
+		if metav1.IsControlledBy(obj, s.Owner) && obj.GetDeletionTimestamp().IsZero() {
+			objs = append(objs, obj)
+		}
+	}
+
+	return objs, nil
+}
+
+// NewPruneSyncer creates a new syncer which deletes the objects of the given
+// kinds controlled by the owner, except the subjects of the keep syncers. The
+// name is used for logging and event emitting purposes and should be an valid
+// go identifier in upper camel case. (eg. MysqlPrune).
+func NewPruneSyncer(name string, owner client.Object, c client.Client, kinds []schema.GroupVersionKind, keep ...Interface) Interface {
+	return &PruneSyncer{
+		Owner:  owner,
+		Name:   name,
+		Client: c,
+		Kinds:  kinds,
+		Keep:   keep,
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/presslabs/controller-util/pkg/syncer"
+)
+
+var _ = Describe("PruneSyncer", func() {
+	var (
+		recorder *record.FakeRecorder
+		owner    *corev1.ConfigMap
+		key      types.NamespacedName
+		syncers  []syncer.Interface
+		kinds    []schema.GroupVersionKind
+	)
+
+	childKey := func(name string) types.NamespacedName {
+		return types.NamespacedName{Name: key.Name + "-" + name, Namespace: key.Namespace}
+	}
+
+	BeforeEach(func() {
+		r := rand.Int31() //nolint: gosec
+
+		key = types.NamespacedName{
+			Name:      fmt.Sprintf("example-%d", r),
+			Namespace: fmt.Sprintf("default-%d", r),
+		}
+		kinds = []schema.GroupVersionKind{{Version: "v1", Kind: "ConfigMap"}}
+		recorder = record.NewFakeRecorder(100)
+		owner = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+		}
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: key.Namespace,
+			},
+		}
+		Expect(c.Create(context.TODO(), ns)).To(Succeed())
+		Expect(c.Create(context.TODO(), owner)).To(Succeed())
+
+		syncers = nil
+		for _, name := range []string{"kept", "pruned"} {
+			k := childKey(name)
+			cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: k.Name, Namespace: k.Namespace}}
+			s := syncer.NewObjectSyncer("Child", owner, cm, c, func() error { return nil })
+			Expect(s.Sync(context.TODO())).Error().NotTo(HaveOccurred())
+
+			syncers = append(syncers, s)
+		}
+	})
+
+	It("deletes the controlled objects which are not kept", func() {
+		Expect(syncer.Sync(context.TODO(), syncer.NewPruneSyncer("ExamplePrune", owner, c, kinds, syncers[0]), recorder)).To(Succeed())
+
+		Expect(c.Get(context.TODO(), childKey("kept"), &corev1.ConfigMap{})).To(Succeed())
+		Expect(k8serrors.IsNotFound(c.Get(context.TODO(), childKey("pruned"), &corev1.ConfigMap{}))).To(BeTrue())
+		// the owner is not controlled by itself
+		Expect(c.Get(context.TODO(), key, &corev1.ConfigMap{})).To(Succeed())
+
+		Expect(<-recorder.Events).To(Equal(
+			fmt.Sprintf("Normal ExamplePruneSyncSuccessfull ConfigMap %s/%s-pruned pruned successfully", key.Namespace, key.Name),
+		))
+		Consistently(recorder.Events).ShouldNot(Receive())
+	})
+
+	It("skips the kinds which are not installed", func() {
+		kinds = append(kinds, schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Missing"})
+
+		Expect(syncer.Sync(context.TODO(), syncer.NewPruneSyncer("ExamplePrune", owner, c, kinds, syncers[0]), recorder)).To(Succeed())
+
+		Expect(k8serrors.IsNotFound(c.Get(context.TODO(), childKey("pruned"), &corev1.ConfigMap{}))).To(BeTrue())
+		Expect(<-recorder.Events).To(HavePrefix("Normal ExamplePruneSyncSuccessfull"))
+	})
+
+	It("only reports the objects to prune in dry-run mode", func() {
+		pruneSyncer, convOk := syncer.NewPruneSyncer("ExamplePrune", owner, c, kinds, syncers[0]).(*syncer.PruneSyncer)
+		Expect(convOk).To(BeTrue())
+
+		Expect(syncer.Sync(syncer.WithDryRun(context.TODO()), pruneSyncer, recorder)).To(Succeed())
+
+		Expect(pruneSyncer.Pruned).To(HaveLen(1))
+		Expect(pruneSyncer.Pruned[0].GetName()).To(Equal(childKey("pruned").Name))
+		Expect(c.Get(context.TODO(), childKey("pruned"), &corev1.ConfigMap{})).To(Succeed())
+		Consistently(recorder.Events).ShouldNot(Receive())
+	})
+})
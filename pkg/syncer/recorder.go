@@ -0,0 +1,314 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// DefaultRepeatExpiry is the default EventPolicy.RepeatExpiry, the default
+// lifetime of the events in the API server.
+const DefaultRepeatExpiry = time.Hour
+
+// EventPolicy configures which events are recorded by a PolicyRecorder.
+type EventPolicy struct {
+	// Window is the duration during which events with the same reason for the
+	// same object are deduplicated and during which MaxEvents applies.
+	Window time.Duration
+	// MaxEvents is the maximum number of events recorded for an object within
+	// the window. Zero means no limit. It requires a Window, as it is ignored
+	// when the Window is zero.
+	MaxEvents int
+	// SuppressRepeated drops the events identical to the last event recorded
+	// for the same object, regardless of the window, until a different event
+	// is recorded for the object or the RepeatExpiry passes. It also drops the
+	// paused events of the syncers which were already paused, until they
+	// resume or the RepeatExpiry passes.
+	SuppressRepeated bool
+	// RepeatExpiry is the duration after which a repeated event is recorded
+	// again. Defaults to DefaultRepeatExpiry.
+	RepeatExpiry time.Duration
+}
+
+// PolicyRecorder is a record.EventRecorder which drops the events not allowed
+// by an EventPolicy, before passing them to the wrapped recorder. It can be
+// used with Sync to avoid flooding the owner namespace with events when a
+// syncer is stuck in an error loop. The number of events suppressed since the
+// last recorded one is appended to the message of the next recorded event
+// with the same reason for the same object.
+type PolicyRecorder struct {
+	recorder record.EventRecorder
+	policy   EventPolicy
+
+	mu sync.Mutex
+	// recorded holds the times of the events recorded per object within the window
+	recorded map[string][]time.Time
+	// last holds the time of the last event recorded per object and reason
+	last map[eventKey]time.Time
+	// latest holds the last event recorded per object
+	latest map[string]recordedEvent
+	// pending holds the events suppressed since the last recorded one per
+	// object and reason
+	pending map[eventKey]suppressedEvents
+	// paused holds the time the paused event was recorded per syncer and reason
+	paused     map[string]map[string]time.Time
+	suppressed int
+	swept      time.Time
+
+	now func() time.Time
+}
+
+type eventKey struct {
+	object string
+	reason string
+}
+
+type recordedEvent struct {
+	eventType string
+	reason    string
+	message   string
+	time      time.Time
+}
+
+type suppressedEvents struct {
+	count int
+	time  time.Time
+}
+
+var _ record.EventRecorder = &PolicyRecorder{}
+
+// NewPolicyRecorder creates a new recorder which records events allowed by the
+// policy using the given recorder.
+func NewPolicyRecorder(recorder record.EventRecorder, policy EventPolicy) *PolicyRecorder {
+	return &PolicyRecorder{
+		recorder: recorder,
+		policy:   policy,
+		recorded: map[string][]time.Time{},
+		last:     map[eventKey]time.Time{},
+		latest:   map[string]recordedEvent{},
+		pending:  map[eventKey]suppressedEvents{},
+		paused:   map[string]map[string]time.Time{},
+		now:      time.Now,
+	}
+}
+
+// Event records an event if it is allowed by the policy.
+func (r *PolicyRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	if message, ok := r.allow(object, eventtype, reason, message); ok {
+		r.recorder.Event(object, eventtype, reason, message)
+	}
+}
+
+// Eventf is just like Event, but with Sprintf for the message field.
+func (r *PolicyRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+// AnnotatedEventf is just like Eventf, but with annotations attached.
+func (r *PolicyRecorder) AnnotatedEventf(
+	object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{},
+) {
+	if message, ok := r.allow(object, eventtype, reason, fmt.Sprintf(messageFmt, args...)); ok {
+		r.recorder.AnnotatedEventf(object, annotations, eventtype, reason, "%s", message)
+	}
+}
+
+// Suppressed returns the number of events dropped by the recorder.
+func (r *PolicyRecorder) Suppressed() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.suppressed
+}
+
+// SuppressedFor returns the number of events with the given reason dropped
+// for the object since the last one recorded.
+func (r *PolicyRecorder) SuppressedFor(object runtime.Object, reason string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.pending[eventKey{object: objectID(object), reason: reason}].count
+}
+
+// allow returns true when the event is allowed by the policy and, in that
+// case, accounts it as recorded and returns the message to record.
+func (r *PolicyRecorder) allow(object runtime.Object, eventtype, reason, message string) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	r.sweep(now)
+
+	key := eventKey{object: objectID(object), reason: reason}
+	recorded := r.inWindow(r.recorded[key.object], now)
+	latest, seen := r.latest[key.object]
+	last, seenReason := r.last[key]
+
+	switch {
+	case r.policy.SuppressRepeated && seen && now.Sub(latest.time) < r.repeatExpiry() &&
+		latest.eventType == eventtype && latest.reason == reason && latest.message == message:
+	case seenReason && now.Sub(last) < r.policy.Window:
+	case r.policy.MaxEvents > 0 && len(recorded) >= r.policy.MaxEvents:
+	default:
+		r.recorded[key.object] = append(recorded, now)
+		r.last[key] = now
+		r.latest[key.object] = recordedEvent{eventType: eventtype, reason: reason, message: message, time: now}
+
+		if pending := r.pending[key]; pending.count > 0 {
+			message = fmt.Sprintf("%s (suppressed %d similar events)", message, pending.count)
+			delete(r.pending, key)
+		}
+
+		return message, true
+	}
+
+	pending := r.pending[key]
+	pending.count++
+	pending.time = now
+	r.pending[key] = pending
+	r.suppressed++
+
+	return "", false
+}
+
+// pauseChanged tracks the paused syncers when SuppressRepeated is set and
+// returns false when the result is the paused event of a syncer which was
+// already paused, within the RepeatExpiry.
+func (r *PolicyRecorder) pauseChanged(key string, result SyncResult) bool {
+	if !r.policy.SuppressRepeated {
+		return true
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	r.sweep(now)
+
+	if result.Operation != OperationResultPaused {
+		delete(r.paused, key)
+
+		return true
+	}
+
+	reasons, ok := r.paused[key]
+	if !ok {
+		reasons = map[string]time.Time{}
+		r.paused[key] = reasons
+	}
+
+	if recorded, ok := reasons[result.EventReason]; ok && now.Sub(recorded) < r.repeatExpiry() {
+		r.suppressed++
+
+		return false
+	}
+
+	reasons[result.EventReason] = now
+
+	return true
+}
+
+// inWindow returns the times within the window.
+func (r *PolicyRecorder) inWindow(times []time.Time, now time.Time) []time.Time {
+	result := []time.Time{}
+
+	for _, t := range times {
+		if now.Sub(t) < r.policy.Window {
+			result = append(result, t)
+		}
+	}
+
+	return result
+}
+
+// sweep forgets the events which no longer affect the policy, so the recorder
+// doesn't grow with the objects it recorded events for. It runs at most once
+// per RepeatExpiry.
+func (r *PolicyRecorder) sweep(now time.Time) {
+	expiry := r.repeatExpiry()
+	if now.Sub(r.swept) < expiry {
+		return
+	}
+
+	r.swept = now
+
+	for object, times := range r.recorded {
+		if times = r.inWindow(times, now); len(times) > 0 {
+			r.recorded[object] = times
+		} else {
+			delete(r.recorded, object)
+		}
+	}
+
+	for key, t := range r.last {
+		if now.Sub(t) >= r.policy.Window {
+			delete(r.last, key)
+		}
+	}
+
+	for object, event := range r.latest {
+		if now.Sub(event.time) >= expiry {
+			delete(r.latest, object)
+		}
+	}
+
+	for key, pending := range r.pending {
+		if now.Sub(pending.time) >= max(expiry, r.policy.Window) {
+			delete(r.pending, key)
+		}
+	}
+
+	for key, reasons := range r.paused {
+		for reason, t := range reasons {
+			if now.Sub(t) >= expiry {
+				delete(reasons, reason)
+			}
+		}
+
+		if len(reasons) == 0 {
+			delete(r.paused, key)
+		}
+	}
+}
+
+func (r *PolicyRecorder) repeatExpiry() time.Duration {
+	if r.policy.RepeatExpiry == 0 {
+		return DefaultRepeatExpiry
+	}
+
+	return r.policy.RepeatExpiry
+}
+
+// objectID returns an identifier of the object events are recorded for.
+func objectID(object runtime.Object) string {
+	accessor, err := apimeta.Accessor(object)
+	if err != nil {
+		return fmt.Sprintf("%p", object)
+	}
+
+	if uid := accessor.GetUID(); uid != "" {
+		return string(uid)
+	}
+
+	return fmt.Sprintf("%T %s/%s", object, accessor.GetNamespace(), accessor.GetName())
+}
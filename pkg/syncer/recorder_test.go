@@ -0,0 +1,133 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("PolicyRecorder", func() {
+	var (
+		fakeRecorder *record.FakeRecorder
+		now          time.Time
+		owner        *corev1.ConfigMap
+		other        *corev1.ConfigMap
+	)
+
+	newRecorder := func(policy EventPolicy) *PolicyRecorder {
+		recorder := NewPolicyRecorder(fakeRecorder, policy)
+		recorder.now = func() time.Time { return now }
+
+		return recorder
+	}
+
+	BeforeEach(func() {
+		fakeRecorder = record.NewFakeRecorder(100)
+		now = time.Now()
+		owner = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: "default", UID: "owner-uid"}}
+		other = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default", UID: "other-uid"}}
+	})
+
+	It("deduplicates events with the same reason within the window", func() {
+		recorder := newRecorder(EventPolicy{Window: time.Minute})
+
+		recorder.Eventf(owner, eventWarning, "SyncFailed", "failed: %s", "first")
+		recorder.Eventf(owner, eventWarning, "SyncFailed", "failed: %s", "second")
+		recorder.Event(owner, eventNormal, "SyncSuccessfull", "synced")
+		recorder.Event(other, eventWarning, "SyncFailed", "failed: other")
+
+		now = now.Add(time.Minute)
+		recorder.Event(owner, eventWarning, "SyncFailed", "failed: third")
+
+		Expect(fakeRecorder.Events).To(HaveLen(4))
+		Expect(<-fakeRecorder.Events).To(Equal("Warning SyncFailed failed: first"))
+		Expect(<-fakeRecorder.Events).To(Equal("Normal SyncSuccessfull synced"))
+		Expect(<-fakeRecorder.Events).To(Equal("Warning SyncFailed failed: other"))
+		Expect(<-fakeRecorder.Events).To(Equal("Warning SyncFailed failed: third (suppressed 1 similar events)"))
+		Expect(recorder.Suppressed()).To(Equal(1))
+	})
+
+	It("caps the number of events recorded for an object", func() {
+		recorder := newRecorder(EventPolicy{Window: time.Minute, MaxEvents: 2})
+
+		for _, reason := range []string{"A", "B", "C"} {
+			recorder.Event(owner, eventNormal, reason, "message")
+		}
+
+		Expect(fakeRecorder.Events).To(HaveLen(2))
+
+		now = now.Add(time.Minute)
+		recorder.Event(owner, eventNormal, "C", "message")
+
+		Expect(fakeRecorder.Events).To(HaveLen(3))
+		Expect(recorder.Suppressed()).To(Equal(1))
+	})
+
+	It("suppresses repeated identical events", func() {
+		recorder := newRecorder(EventPolicy{SuppressRepeated: true})
+
+		recorder.Event(owner, eventWarning, "SyncFailed", "failed")
+		now = now.Add(30 * time.Minute)
+		recorder.Event(owner, eventWarning, "SyncFailed", "failed")
+		Expect(recorder.SuppressedFor(owner, "SyncFailed")).To(Equal(1))
+
+		recorder.Event(owner, eventWarning, "SyncFailed", "failed differently")
+
+		Expect(fakeRecorder.Events).To(HaveLen(2))
+		Expect(<-fakeRecorder.Events).To(Equal("Warning SyncFailed failed"))
+		Expect(<-fakeRecorder.Events).To(Equal("Warning SyncFailed failed differently (suppressed 1 similar events)"))
+		Expect(recorder.Suppressed()).To(Equal(1))
+		Expect(recorder.SuppressedFor(owner, "SyncFailed")).To(BeZero())
+	})
+
+	It("records a repeated event again after another event or after it expires", func() {
+		recorder := newRecorder(EventPolicy{SuppressRepeated: true, RepeatExpiry: time.Minute})
+
+		recorder.Event(owner, eventWarning, "SyncFailed", "failed")
+		recorder.Event(owner, eventNormal, "SyncSuccessfull", "synced")
+		recorder.Event(owner, eventWarning, "SyncFailed", "failed")
+
+		now = now.Add(time.Minute)
+		recorder.Event(owner, eventWarning, "SyncFailed", "failed")
+
+		Expect(fakeRecorder.Events).To(HaveLen(4))
+		Expect(recorder.Suppressed()).To(BeZero())
+	})
+
+	It("forgets the objects once their events expired", func() {
+		recorder := newRecorder(EventPolicy{Window: time.Minute, SuppressRepeated: true, RepeatExpiry: time.Minute})
+
+		recorder.Event(owner, eventWarning, "SyncFailed", "failed")
+		recorder.Event(owner, eventWarning, "SyncFailed", "failed")
+		recorder.Event(other, eventWarning, "SyncFailed", "failed")
+
+		now = now.Add(2 * time.Minute)
+		recorder.Event(other, eventWarning, "SyncFailed", "failed")
+
+		Expect(recorder.recorded).To(HaveLen(1))
+		Expect(recorder.last).To(HaveLen(1))
+		Expect(recorder.latest).To(HaveLen(1))
+		Expect(recorder.pending).To(BeEmpty())
+	})
+})
@@ -0,0 +1,208 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// OperationResultRecreated is the result of an ObjectSyncer which deleted
+	// and created again the object, because the SyncFn changed immutable fields.
+	OperationResultRecreated controllerutil.OperationResult = "recreated"
+	// OperationResultRecreating is the result of an ObjectSyncer which deleted
+	// the object for recreating it, but the object is still being deleted (eg.
+	// it has finalizers or its dependents are orphaned). The object is created
+	// by a following sync, once it's gone, which reports it created.
+	OperationResultRecreating controllerutil.OperationResult = "recreating"
+)
+
+// errRecreating is returned by the mutate function of an ObjectSyncer when the
+// object is still being deleted for recreating it.
+var errRecreating = errors.New("object is being deleted for recreating")
+
+// RecreatePolicy configures an ObjectSyncer to delete and create again the
+// object when it cannot be updated because the SyncFn changed immutable fields
+// (eg. a StatefulSet selector or volumeClaimTemplates, a Job template or a
+// Service clusterIP).
+type RecreatePolicy struct {
+	// PropagationPolicy determines how the dependents of the object are
+	// garbage collected when it is deleted. Use metav1.DeletePropagationOrphan
+	// to keep them (eg. the pods of a StatefulSet). The object is gone only
+	// after the garbage collector orphaned the dependents, so the syncer
+	// reports OperationResultRecreating until then.
+	PropagationPolicy *metav1.DeletionPropagation
+}
+
+// immutableFieldMessages are the error messages of the apiserver for updates
+// which change immutable fields.
+var immutableFieldMessages = []string{
+	"field is immutable",
+	"may not change once set",
+	"updates to statefulset spec for fields other than",
+}
+
+// isImmutableFieldError returns true when the update failed because it
+// changed immutable fields.
+func isImmutableFieldError(err error) bool {
+	if !k8serrors.IsInvalid(err) {
+		return false
+	}
+
+	for _, msg := range immutableFieldMessages {
+		if strings.Contains(err.Error(), msg) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// persist persists the subject using the configured strategy and recreates
+// it, if the syncer has a RecreatePolicy, when updating it fails because of
+// immutable fields. In observe-only mode it only observes the subject.
+// Nothing is persisted when the owner or the subject are paused.
+func (s *ObjectSyncer) persist(ctx context.Context) (controllerutil.OperationResult, error) {
+	initial := deepCopy(s.Obj)
+
+	if isPaused(s.Owner) {
+		s.previousObject = initial
+
+		return OperationResultPaused, nil
+	}
+
+	if s.ObserveOnly {
+		return s.observe(ctx)
+	}
+
+	op, err := s.createOrUpdate(ctx)
+	if errors.Is(err, errPaused) {
+		return OperationResultPaused, nil
+	}
+
+	if errors.Is(err, errObserveOnly) {
+		restore(s.Obj, initial)
+
+		return s.observe(ctx)
+	}
+
+	if errors.Is(err, errRecreating) {
+		return OperationResultRecreating, nil
+	}
+
+	if s.Recreate == nil || !isImmutableFieldError(err) {
+		return op, err
+	}
+
+	return s.recreate(ctx, initial, err)
+}
+
+// recreate deletes the object, which cannot be updated because of immutable
+// fields, and creates it again from the initial object passed to the syncer.
+func (s *ObjectSyncer) recreate(ctx context.Context, initial client.Object, cause error) (controllerutil.OperationResult, error) {
+	log := logf.FromContext(ctx, "syncer", s.Name)
+	log.Info("recreating object with changed immutable fields", "key", client.ObjectKeyFromObject(s.Obj), "error", cause)
+
+	// keep the object before recreating it for computing the diff
+	previous := s.previousObject
+
+	var uid types.UID
+	if live, ok := previous.(client.Object); ok {
+		uid = live.GetUID()
+	}
+
+	// delete only the object which failed updating, not a newer one with the
+	// same name, in which case the conflict is returned
+	opts := []client.DeleteOption{}
+	if uid != "" {
+		opts = append(opts, client.Preconditions{UID: ptr.To(uid)})
+	}
+
+	if s.Recreate.PropagationPolicy != nil {
+		opts = append(opts, client.PropagationPolicy(*s.Recreate.PropagationPolicy))
+	}
+
+	if err := clientFor(ctx, s.Client).Delete(ctx, deepCopy(s.Obj), opts...); client.IgnoreNotFound(err) != nil {
+		return controllerutil.OperationResultNone, fmt.Errorf("error when deleting object for recreating it: %w", err)
+	}
+
+	if IsDryRun(ctx) {
+		// the object is not actually deleted, so it cannot be created again
+		return OperationResultRecreated, nil
+	}
+
+	// start over from the object passed to the syncer, as a new object
+	restore(s.Obj, initial)
+	s.Obj.SetResourceVersion("")
+	s.Obj.SetUID("")
+	s.Obj.SetCreationTimestamp(metav1.Time{})
+
+	op, err := s.createOrUpdate(ctx)
+	s.previousObject = previous
+
+	if err == nil && op == controllerutil.OperationResultCreated {
+		return OperationResultRecreated, nil
+	}
+
+	if errors.Is(err, errRecreating) || s.stillExists(ctx, uid) {
+		// the object is still being deleted (eg. it has finalizers or its
+		// dependents are being orphaned) or the client cache didn't observe
+		// the deletion yet, so it's created by a following sync
+		log.V(1).Info("object is still being deleted", "key", client.ObjectKeyFromObject(s.Obj), "error", err)
+
+		return OperationResultRecreating, nil
+	}
+
+	if err != nil {
+		return op, fmt.Errorf("error when recreating object: %w", err)
+	}
+
+	return op, nil
+}
+
+// stillExists returns true when the deleted object, with the given UID, still
+// exists or the client cache still holds it.
+func (s *ObjectSyncer) stillExists(ctx context.Context, uid types.UID) bool {
+	if uid == "" {
+		return false
+	}
+
+	readers := []client.Reader{s.Client}
+	if s.APIReader != nil {
+		readers = append(readers, s.APIReader)
+	}
+
+	for _, reader := range readers {
+		live := deepCopy(s.Obj)
+		if err := reader.Get(ctx, client.ObjectKeyFromObject(s.Obj), live); err == nil && live.GetUID() == uid {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+var _ = Describe("RecreatePolicy", func() {
+	var (
+		c         client.Client
+		key       types.NamespacedName
+		deleted   []client.DeleteOptions
+		createErr error
+	)
+
+	newSyncer := func() *ObjectSyncer {
+		obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+		orphan := metav1.DeletePropagationOrphan
+
+		return &ObjectSyncer{
+			Name:     "Example",
+			Obj:      obj,
+			Client:   c,
+			Recreate: &RecreatePolicy{PropagationPolicy: &orphan},
+			SyncFn: func() error {
+				obj.Data = map[string]string{"key": "changed"}
+
+				return nil
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		key = types.NamespacedName{Name: "example", Namespace: "default"}
+		deleted = nil
+		createErr = nil
+
+		// the existing object has a finalizer, like the orphan finalizer set
+		// by the apiserver, so it's not gone right after deleting it
+		c = fake.NewClientBuilder().WithObjects(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: key.Name, Namespace: key.Namespace, UID: "old-uid", Finalizers: []string{"example.com/finalizer"},
+			},
+		}).WithInterceptorFuncs(interceptor.Funcs{
+			Update: func(context.Context, client.WithWatch, client.Object, ...client.UpdateOption) error {
+				return k8serrors.NewInvalid(schema.GroupKind{Kind: "ConfigMap"}, key.Name, field.ErrorList{
+					field.Invalid(field.NewPath("data"), nil, "field is immutable"),
+				})
+			},
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				if createErr != nil {
+					return createErr
+				}
+
+				return c.Create(ctx, obj, opts...)
+			},
+			Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				deleteOpts := client.DeleteOptions{}
+				deleteOpts.ApplyOptions(opts)
+				deleted = append(deleted, deleteOpts)
+
+				return c.Delete(ctx, obj, opts...)
+			},
+		}).Build()
+	})
+
+	It("reports the object recreating until it's gone and creates it afterwards", func() {
+		for range 2 {
+			result, err := newSyncer().Sync(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Operation).To(Equal(OperationResultRecreating))
+		}
+
+		Expect(deleted).To(HaveLen(1))
+		Expect(deleted[0].Preconditions.UID).To(HaveValue(BeEquivalentTo("old-uid")))
+
+		live := &corev1.ConfigMap{}
+		Expect(c.Get(context.TODO(), key, live)).To(Succeed())
+		Expect(live.DeletionTimestamp).NotTo(BeNil())
+
+		// updates are rejected by the interceptor, so remove the finalizer using a patch
+		patch := client.MergeFrom(live.DeepCopy())
+		live.Finalizers = nil
+		Expect(c.Patch(context.TODO(), live, patch)).To(Succeed())
+
+		result, err := newSyncer().Sync(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Operation).To(Equal(controllerutil.OperationResultCreated))
+
+		Expect(c.Get(context.TODO(), key, live)).To(Succeed())
+		Expect(live.Data).To(HaveKeyWithValue("key", "changed"))
+	})
+
+	It("returns the errors of creating the object again", func() {
+		live := &corev1.ConfigMap{}
+		Expect(c.Get(context.TODO(), key, live)).To(Succeed())
+
+		// without finalizers the object is gone right after deleting it
+		patch := client.MergeFrom(live.DeepCopy())
+		live.Finalizers = nil
+		Expect(c.Patch(context.TODO(), live, patch)).To(Succeed())
+
+		createErr = k8serrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, key.Name,
+			errors.New("namespace is being terminated")) //nolint: err113
+
+		result, err := newSyncer().Sync(context.TODO())
+		Expect(err).To(MatchError(ContainSubstring("namespace is being terminated")))
+		Expect(result.Operation).NotTo(Equal(OperationResultRecreating))
+		Expect(result.EventType).To(Equal("Warning"))
+	})
+})
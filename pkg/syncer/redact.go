@@ -0,0 +1,236 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// RedactAnnotation is the annotation through which objects opt in to have
+// additional fields redacted. Its value is a comma separated list of field
+// paths, in the format accepted by Redactor.Register.
+const RedactAnnotation = "controller-util.presslabs.com/redact"
+
+// podSpecRedactions are the redacted fields of a pod spec.
+var podSpecRedactions = []string{
+	"containers[].env[].value",
+	"initContainers[].env[].value",
+	"ephemeralContainers[].env[].value",
+}
+
+// Redactor removes sensitive data from objects, making them suitable for
+// logging and diffing. Fields are redacted based on rules registered per
+// GroupVersionKind and on the RedactAnnotation of the objects.
+type Redactor struct {
+	mu    sync.RWMutex
+	rules map[schema.GroupVersionKind][]string
+}
+
+// DefaultRedactor is the Redactor used by syncers which don't specify one.
+// It redacts Secrets and ConfigMaps data and the environment variables values
+// of the builtin workloads.
+var DefaultRedactor = newDefaultRedactor()
+
+// NewRedactor returns a new Redactor without any rules.
+func NewRedactor() *Redactor {
+	return &Redactor{
+		rules: map[schema.GroupVersionKind][]string{},
+	}
+}
+
+func newDefaultRedactor() *Redactor {
+	r := NewRedactor()
+
+	r.Register(schema.GroupVersionKind{Version: "v1", Kind: "Secret"}, "data", "stringData")
+	r.Register(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, "data", "binaryData")
+	r.Register(schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, prefixed("spec.", podSpecRedactions)...)
+	r.Register(schema.GroupVersionKind{Version: "v1", Kind: "PodTemplate"}, prefixed("template.spec.", podSpecRedactions)...)
+	r.Register(schema.GroupVersionKind{Version: "v1", Kind: "ReplicationController"}, prefixed("spec.template.spec.", podSpecRedactions)...)
+
+	for _, gvk := range []schema.GroupVersionKind{
+		{Group: "apps", Version: "v1", Kind: "Deployment"},
+		{Group: "apps", Version: "v1", Kind: "StatefulSet"},
+		{Group: "apps", Version: "v1", Kind: "DaemonSet"},
+		{Group: "apps", Version: "v1", Kind: "ReplicaSet"},
+		{Group: "batch", Version: "v1", Kind: "Job"},
+	} {
+		r.Register(gvk, prefixed("spec.template.spec.", podSpecRedactions)...)
+	}
+
+	r.Register(schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "CronJob"},
+		prefixed("spec.jobTemplate.spec.template.spec.", podSpecRedactions)...)
+
+	return r
+}
+
+func prefixed(prefix string, paths []string) []string {
+	result := make([]string, len(paths))
+	for i, path := range paths {
+		result[i] = prefix + path
+	}
+
+	return result
+}
+
+// Register adds redaction rules for the given GroupVersionKind. A rule is a
+// dot separated field path. A field followed by [] matches all the elements of
+// a list and * matches all the values of a map, eg.
+// spec.template.spec.containers[].env[].value or data.*.password.
+func (r *Redactor) Register(gvk schema.GroupVersionKind, paths ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.rules[gvk] = append(r.rules[gvk], paths...)
+}
+
+// Redact returns a copy of the object with the sensitive fields removed or
+// the object itself if there is nothing to redact. The scheme is used to find
+// the kind of typed objects and defaults to the client-go scheme.
+func (r *Redactor) Redact(obj runtime.Object, scheme *runtime.Scheme) runtime.Object {
+	if v := reflect.ValueOf(obj); !v.IsValid() || (v.Kind() == reflect.Pointer && v.IsNil()) {
+		return obj
+	}
+
+	paths := r.paths(obj, scheme)
+	if len(paths) == 0 {
+		return obj
+	}
+
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj.DeepCopyObject())
+	if err != nil {
+		return obj
+	}
+
+	redacted := false
+	for _, path := range paths {
+		redacted = removeField(u, strings.Split(path, ".")) || redacted
+	}
+
+	if !redacted {
+		return obj
+	}
+
+	if _, ok := obj.(runtime.Unstructured); !ok {
+		typed, ok := reflect.New(reflect.TypeOf(obj).Elem()).Interface().(runtime.Object)
+		if ok && runtime.DefaultUnstructuredConverter.FromUnstructured(u, typed) == nil {
+			return typed
+		}
+	}
+
+	return &unstructured.Unstructured{Object: u}
+}
+
+// paths returns the redacted paths for the object.
+func (r *Redactor) paths(obj runtime.Object, scheme *runtime.Scheme) []string {
+	if scheme == nil {
+		scheme = clientgoscheme.Scheme
+	}
+
+	paths := []string{}
+
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if gvk.Empty() {
+		gvk, _ = apiutil.GVKForObject(obj, scheme)
+	}
+
+	r.mu.RLock()
+	paths = append(paths, r.rules[gvk]...)
+	r.mu.RUnlock()
+
+	if accessor, err := meta.Accessor(obj); err == nil {
+		if annotation := accessor.GetAnnotations()[RedactAnnotation]; annotation != "" {
+			for _, path := range strings.Split(annotation, ",") {
+				paths = append(paths, strings.TrimSpace(path))
+			}
+		}
+	}
+
+	return paths
+}
+
+// removeField removes the field at the given path and returns true if it was found.
+func removeField(obj map[string]interface{}, path []string) bool {
+	if len(path) == 0 {
+		return false
+	}
+
+	key, isList := strings.CutSuffix(path[0], "[]")
+	rest := path[1:]
+
+	fields := []string{key}
+	if key == "*" {
+		fields = make([]string, 0, len(obj))
+		for field := range obj {
+			fields = append(fields, field)
+		}
+	}
+
+	removed := false
+
+	for _, field := range fields {
+		value, ok := obj[field]
+		if !ok {
+			continue
+		}
+
+		if len(rest) == 0 {
+			delete(obj, field)
+
+			removed = true
+
+			continue
+		}
+
+		removed = removeNestedField(value, rest, isList) || removed
+	}
+
+	return removed
+}
+
+// removeNestedField removes the field at the given path from a map or, when
+// isList is true, from all the maps in a list.
+func removeNestedField(value interface{}, path []string, isList bool) bool {
+	if !isList {
+		nested, ok := value.(map[string]interface{})
+
+		return ok && removeField(nested, path)
+	}
+
+	items, ok := value.([]interface{})
+	if !ok {
+		return false
+	}
+
+	removed := false
+
+	for _, item := range items {
+		if nested, ok := item.(map[string]interface{}); ok {
+			removed = removeField(nested, path) || removed
+		}
+	}
+
+	return removed
+}
@@ -0,0 +1,309 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var (
+	errEmptySelector = errors.New("a non-empty selector is required for deleting a collection")
+	errNoOwner       = errors.New("an owner is required for deleting only the owned objects")
+)
+
+// RemoveCollectionSyncer is a syncer.Interface for deleting all the
+// kubernetes.Objects of a kind matching a label selector, in the owner
+// namespace, or in all namespaces for cluster-scoped owners. The selector
+// must not be empty, so a missing selector never deletes all the objects of
+// the kind.
+type RemoveCollectionSyncer struct {
+	Owner    client.Object
+	List     client.ObjectList
+	Selector labels.Selector
+	Name     string
+	Client   client.Client
+
+	// OwnedOnly limits the deletion to the objects controlled by the owner.
+	OwnedOnly bool
+	// MaxAge limits the deletion to the objects older than it. Zero means
+	// objects are deleted regardless of their age.
+	MaxAge time.Duration
+	// KeepLast is the number of most recently created objects which are kept.
+	KeepLast int
+	// DeleteAllOf deletes the objects using a single DeleteAllOf request,
+	// instead of deleting the listed objects one by one. The request deletes
+	// all the objects matching the selector, including the ones created after
+	// listing them, so the count reported is not exact. It is not used when
+	// the objects are filtered by OwnedOnly, MaxAge or KeepLast, or when
+	// paused objects match the selector.
+	DeleteAllOf bool
+	// PropagationPolicy determines how the dependents of the objects are
+	// garbage collected (eg. the pods of Jobs, which are orphaned by default).
+	// Defaults to the policy of the object kind.
+	PropagationPolicy *metav1.DeletionPropagation
+
+	// Deleted holds the objects deleted by the last sync, or the ones which
+	// would have been deleted in dry-run mode.
+	Deleted []client.Object
+}
+
+// Object returns the RemoveCollectionSyncer subject.
+func (s *RemoveCollectionSyncer) Object() interface{} {
+	return s.List
+}
+
+// ObjectOwner returns the RemoveCollectionSyncer owner.
+func (s *RemoveCollectionSyncer) ObjectOwner() runtime.Object {
+	return s.Owner
+}
+
+// Sync does the actual syncing and implements the syncer.Inteface Sync method.
+func (s *RemoveCollectionSyncer) Sync(ctx context.Context) (SyncResult, error) {
+	start := time.Now()
+	result, err := s.sync(ctx)
+	observeSync(ctx, s.Name, s.itemType(), result.Operation, err, start)
+
+	return result, err
+}
+
+func (s *RemoveCollectionSyncer) sync(ctx context.Context) (SyncResult, error) {
+	result := SyncResult{}
+	log := logf.FromContext(ctx, "syncer", s.Name)
+
+	result.Operation = controllerutil.OperationResultNone
+	s.Deleted = nil
+
+	if isPaused(s.Owner) {
+		setPaused(ctx, &result, s.Name, s.itemType()+" collection")
+
+		return result, nil
+	}
+
+	if err := s.validate(); err != nil {
+		result.SetEventData(eventWarning, basicEventReason(s.Name, err),
+			fmt.Sprintf("%s objects not deleted: %s", s.itemType(), err))
+		log.Error(err, string(result.Operation), "kind", s.itemType())
+
+		return result, err
+	}
+
+	objs, skipped, err := s.listDeletable(ctx)
+	if err != nil {
+		log.Error(err, string(result.Operation), "kind", s.itemType())
+
+		return result, fmt.Errorf("error when listing resources: %w", err)
+	}
+
+	if len(objs) == 0 {
+		return result, nil
+	}
+
+	if !s.DeleteAllOf || s.filtered() || skipped {
+		err = s.deleteEach(ctx, objs)
+	} else {
+		err = clientFor(ctx, s.Client).DeleteAllOf(ctx, objs[0], s.deleteAllOfOptions())
+		if err == nil {
+			s.Deleted = objs
+		}
+	}
+
+	if len(s.Deleted) > 0 {
+		result.Operation = OperationResultDeleted
+	}
+
+	if err != nil {
+		result.SetEventData(eventWarning, basicEventReason(s.Name, err),
+			fmt.Sprintf("%d of %d %s objects deleted: %s", len(s.Deleted), len(objs), s.itemType(), err))
+
+		log.Error(err, string(result.Operation), "kind", s.itemType(), "deleted", len(s.Deleted), "total", len(objs))
+
+		return result, fmt.Errorf("error when deleting resources: %w", err)
+	}
+
+	result.SetEventData(eventNormal, basicEventReason(s.Name, nil),
+		fmt.Sprintf("%d %s objects successfully deleted", len(s.Deleted), s.itemType()))
+
+	log.V(1).Info(string(result.Operation), "kind", s.itemType(), "deleted", len(s.Deleted))
+
+	return result, nil
+}
+
+func (s *RemoveCollectionSyncer) deleteEach(ctx context.Context, objs []client.Object) error {
+	errs := []error{}
+
+	for _, obj := range objs {
+		if err := clientFor(ctx, s.Client).Delete(ctx, obj, s.deleteOptions()...); client.IgnoreNotFound(err) != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", client.ObjectKeyFromObject(obj), err))
+
+			continue
+		}
+
+		s.Deleted = append(s.Deleted, obj)
+	}
+
+	return errors.Join(errs...)
+}
+
+// listDeletable lists the objects matching the selector, which are not
+// already being deleted and are not retained by the syncer filters. It
+// reports whether paused objects were skipped, as they must not be deleted
+// with the collection.
+func (s *RemoveCollectionSyncer) listDeletable(ctx context.Context) ([]client.Object, bool, error) {
+	if err := s.Client.List(ctx, s.List, s.listOptions()); err != nil {
+		return nil, false, err
+	}
+
+	items, err := apimeta.ExtractList(s.List)
+	if err != nil {
+		return nil, false, err
+	}
+
+	objs := []client.Object{}
+
+	for _, item := range items {
+		obj, ok := item.(client.Object)
+		if !ok || !obj.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+
+		if s.OwnedOnly && !metav1.IsControlledBy(obj, s.Owner) {
+			continue
+		}
+
+		objs = append(objs, obj)
+	}
+
+	if s.KeepLast > 0 {
+		// newest first
+		sort.SliceStable(objs, func(i, j int) bool {
+			return objs[j].GetCreationTimestamp().Time.Before(objs[i].GetCreationTimestamp().Time)
+		})
+
+		objs = objs[min(s.KeepLast, len(objs)):]
+	}
+
+	if s.MaxAge > 0 {
+		deadline := time.Now().Add(-s.MaxAge)
+		old := []client.Object{}
+
+		for _, obj := range objs {
+			if obj.GetCreationTimestamp().Time.Before(deadline) {
+				old = append(old, obj)
+			}
+		}
+
+		objs = old
+	}
+
+	deletable := []client.Object{}
+
+	for _, obj := range objs {
+		if !isPaused(obj) {
+			deletable = append(deletable, obj)
+		}
+	}
+
+	return deletable, len(deletable) < len(objs), nil
+}
+
+// validate ensures the syncer selects a subset of the objects of the kind.
+func (s *RemoveCollectionSyncer) validate() error {
+	if s.Selector == nil || s.Selector.Empty() {
+		return errEmptySelector
+	}
+
+	if s.OwnedOnly && s.Owner == nil {
+		return errNoOwner
+	}
+
+	return nil
+}
+
+// filtered returns true when objects are selected by other criteria than the
+// label selector, so they cannot be deleted as a collection.
+func (s *RemoveCollectionSyncer) filtered() bool {
+	return s.OwnedOnly || s.MaxAge > 0 || s.KeepLast > 0
+}
+
+// listOptions returns the options selecting the objects, both for listing and
+// for deleting them as a collection.
+func (s *RemoveCollectionSyncer) listOptions() *client.ListOptions {
+	opts := &client.ListOptions{LabelSelector: s.Selector}
+	if s.Owner != nil {
+		opts.Namespace = s.Owner.GetNamespace()
+	}
+
+	return opts
+}
+
+func (s *RemoveCollectionSyncer) deleteOptions() []client.DeleteOption {
+	opts := []client.DeleteOption{}
+
+	if s.PropagationPolicy != nil {
+		opts = append(opts, client.PropagationPolicy(*s.PropagationPolicy))
+	}
+
+	return opts
+}
+
+// deleteAllOfOptions returns the options for deleting the objects as a collection.
+func (s *RemoveCollectionSyncer) deleteAllOfOptions() *client.DeleteAllOfOptions {
+	opts := &client.DeleteAllOfOptions{ListOptions: *s.listOptions()}
+	opts.DeleteOptions.ApplyOptions(s.deleteOptions())
+
+	return opts
+}
+
+// itemType returns the type of the list items.
+func (s *RemoveCollectionSyncer) itemType() string {
+	gvk, err := apiutil.GVKForObject(s.List, s.Client.Scheme())
+	if err != nil {
+		return fmt.Sprintf("%T", s.List)
+	}
+
+	gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+
+	return gvk.String()
+}
+
+// NewRemoveCollectionSyncer creates a new syncer which deletes the objects of
+// the list kind matching the selector in the owner namespace, one by one. The
+// name is used for logging and event emitting purposes and should be an valid
+// go identifier in upper camel case. (eg. MysqlBackupJobs).
+func NewRemoveCollectionSyncer(name string, owner client.Object, list client.ObjectList, selector labels.Selector, c client.Client) Interface {
+	return &RemoveCollectionSyncer{
+		Owner:    owner,
+		List:     list,
+		Selector: selector,
+		Name:     name,
+		Client:   c,
+	}
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/presslabs/controller-util/pkg/syncer"
+)
+
+var _ = Describe("RemoveCollectionSyncer", func() {
+	var (
+		recorder *record.FakeRecorder
+		owner    *corev1.ConfigMap
+		key      types.NamespacedName
+		selector labels.Selector
+	)
+
+	remaining := func() []string {
+		list := &corev1.ConfigMapList{}
+		Expect(c.List(context.TODO(), list, client.InNamespace(key.Namespace))).To(Succeed())
+
+		names := []string{}
+		for _, cm := range list.Items {
+			names = append(names, cm.Name)
+		}
+
+		return names
+	}
+
+	BeforeEach(func() {
+		r := rand.Int31() //nolint: gosec
+
+		key = types.NamespacedName{
+			Name:      fmt.Sprintf("example-%d", r),
+			Namespace: fmt.Sprintf("default-%d", r),
+		}
+		selector = labels.SelectorFromSet(labels.Set{"app": "backup"})
+		recorder = record.NewFakeRecorder(100)
+		owner = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+		}
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: key.Namespace,
+			},
+		}
+		Expect(c.Create(context.TODO(), ns)).To(Succeed())
+		Expect(c.Create(context.TODO(), owner)).To(Succeed())
+
+		// an owned and an un-owned object matching the selector
+		owned := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "owned", Namespace: key.Namespace, Labels: map[string]string{"app": "backup"}},
+		}
+		Expect(syncer.NewObjectSyncer("Owned", owner, owned, c, func() error { return nil }).Sync(context.TODO())).
+			Error().NotTo(HaveOccurred())
+		Expect(c.Create(context.TODO(), &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "unowned", Namespace: key.Namespace, Labels: map[string]string{"app": "backup"}},
+		})).To(Succeed())
+	})
+
+	It("deletes all the objects matching the selector", func() {
+		removeSyncer := syncer.NewRemoveCollectionSyncer("ExampleCleanup", owner, &corev1.ConfigMapList{}, selector, c)
+		Expect(syncer.Sync(context.TODO(), removeSyncer, recorder)).To(Succeed())
+
+		Eventually(remaining).Should(ConsistOf(key.Name))
+
+		Expect(<-recorder.Events).To(Equal("Normal ExampleCleanupSyncSuccessfull 2 /v1, Kind=ConfigMap objects successfully deleted"))
+		Consistently(recorder.Events).ShouldNot(Receive())
+	})
+
+	It("refuses to delete without a selector, or the owned objects without an owner", func() {
+		for _, sel := range []labels.Selector{nil, labels.Everything()} {
+			removeSyncer := syncer.NewRemoveCollectionSyncer("ExampleCleanup", owner, &corev1.ConfigMapList{}, sel, c)
+			Expect(syncer.Sync(context.TODO(), removeSyncer, recorder)).NotTo(Succeed())
+			Expect(<-recorder.Events).To(HavePrefix("Warning ExampleCleanupSyncFailed"))
+		}
+
+		removeSyncer, convOk := syncer.NewRemoveCollectionSyncer("ExampleCleanup", nil, &corev1.ConfigMapList{}, selector, c).(*syncer.RemoveCollectionSyncer)
+		Expect(convOk).To(BeTrue())
+
+		removeSyncer.OwnedOnly = true
+
+		_, err := removeSyncer.Sync(context.TODO())
+		Expect(err).To(HaveOccurred())
+
+		Consistently(remaining).Should(ConsistOf(key.Name, "owned", "unowned"))
+	})
+
+	It("deletes only the objects controlled by the owner", func() {
+		removeSyncer, convOk := syncer.NewRemoveCollectionSyncer("ExampleCleanup", owner, &corev1.ConfigMapList{}, selector, c).(*syncer.RemoveCollectionSyncer)
+		Expect(convOk).To(BeTrue())
+
+		removeSyncer.OwnedOnly = true
+		Expect(syncer.Sync(context.TODO(), removeSyncer, recorder)).To(Succeed())
+
+		Expect(remaining()).To(ConsistOf(key.Name, "unowned"))
+		Expect(<-recorder.Events).To(Equal("Normal ExampleCleanupSyncSuccessfull 1 /v1, Kind=ConfigMap objects successfully deleted"))
+	})
+
+	It("deletes the objects using the propagation policy", func() {
+		foreground := metav1.DeletePropagationForeground
+
+		for _, ownedOnly := range []bool{true, false} {
+			removeSyncer, convOk := syncer.NewRemoveCollectionSyncer("ExampleCleanup", owner, &corev1.ConfigMapList{}, selector, c).(*syncer.RemoveCollectionSyncer)
+			Expect(convOk).To(BeTrue())
+
+			removeSyncer.OwnedOnly = ownedOnly
+			removeSyncer.DeleteAllOf = !ownedOnly
+			removeSyncer.PropagationPolicy = &foreground
+			Expect(syncer.Sync(context.TODO(), removeSyncer, recorder)).To(Succeed())
+		}
+
+		// without a garbage collector, the objects wait for their dependents forever
+		for _, name := range []string{"owned", "unowned"} {
+			cm := &corev1.ConfigMap{}
+			Expect(c.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: key.Namespace}, cm)).To(Succeed())
+			Expect(cm.DeletionTimestamp).NotTo(BeNil())
+			Expect(cm.Finalizers).To(ContainElement(metav1.FinalizerDeleteDependents))
+		}
+	})
+
+	It("deletes the collection using a single request", func() {
+		removeSyncer, convOk := syncer.NewRemoveCollectionSyncer("ExampleCleanup", owner, &corev1.ConfigMapList{}, selector, c).(*syncer.RemoveCollectionSyncer)
+		Expect(convOk).To(BeTrue())
+
+		removeSyncer.DeleteAllOf = true
+		Expect(syncer.Sync(context.TODO(), removeSyncer, recorder)).To(Succeed())
+
+		Eventually(remaining).Should(ConsistOf(key.Name))
+		Expect(<-recorder.Events).To(Equal("Normal ExampleCleanupSyncSuccessfull 2 /v1, Kind=ConfigMap objects successfully deleted"))
+	})
+
+	It("doesn't delete the collection using a single request when paused objects match", func() {
+		unowned := &corev1.ConfigMap{}
+		Expect(c.Get(context.TODO(), types.NamespacedName{Name: "unowned", Namespace: key.Namespace}, unowned)).To(Succeed())
+		unowned.Annotations = map[string]string{syncer.PauseAnnotation: "true"}
+		Expect(c.Update(context.TODO(), unowned)).To(Succeed())
+
+		removeSyncer, convOk := syncer.NewRemoveCollectionSyncer("ExampleCleanup", owner, &corev1.ConfigMapList{}, selector, c).(*syncer.RemoveCollectionSyncer)
+		Expect(convOk).To(BeTrue())
+
+		removeSyncer.DeleteAllOf = true
+		Expect(syncer.Sync(context.TODO(), removeSyncer, recorder)).To(Succeed())
+
+		Expect(remaining()).To(ConsistOf(key.Name, "unowned"))
+		Expect(<-recorder.Events).To(Equal("Normal ExampleCleanupSyncSuccessfull 1 /v1, Kind=ConfigMap objects successfully deleted"))
+	})
+
+	It("keeps the last objects", func() {
+		removeSyncer, convOk := syncer.NewRemoveCollectionSyncer("ExampleCleanup", owner, &corev1.ConfigMapList{}, selector, c).(*syncer.RemoveCollectionSyncer)
+		Expect(convOk).To(BeTrue())
+
+		removeSyncer.KeepLast = 2
+		Expect(syncer.Sync(context.TODO(), removeSyncer, recorder)).To(Succeed())
+
+		Expect(remaining()).To(HaveLen(3))
+		Consistently(recorder.Events).ShouldNot(Receive())
+	})
+})
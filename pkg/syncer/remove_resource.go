@@ -19,20 +19,44 @@ package syncer
 import (
 	"context"
 	"fmt"
+	"time"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
+const (
+	// OperationResultDeleted is the result of a RemoveResourceSyncer which deleted the object.
+	OperationResultDeleted controllerutil.OperationResult = "deleted"
+	// OperationResultDeleting is the result of a RemoveResourceSyncer waiting
+	// for the object to be gone, while its finalizers are still pending.
+	OperationResultDeleting controllerutil.OperationResult = "deleting"
+)
+
 // RemoveResourceSyncer is a syncer.Interface for deleting kubernetes.Objects.
 type RemoveResourceSyncer struct {
 	Owner  client.Object
 	Obj    client.Object
 	Name   string
 	Client client.Client
+
+	// PropagationPolicy determines how the dependents of the object are
+	// garbage collected. Defaults to the policy of the object kind.
+	PropagationPolicy *metav1.DeletionPropagation
+	// GracePeriodSeconds is the duration in seconds before the object is deleted.
+	GracePeriodSeconds *int64
+	// Preconditions must be fulfilled by the object for it to be deleted
+	// (eg. the UID or the resourceVersion).
+	Preconditions *metav1.Preconditions
+	// WaitForDeletion makes the syncer report OperationResultDeleting until
+	// the object is gone, instead of reporting it deleted as soon as the
+	// deletion was requested. Within a Group, the syncers depending on this
+	// one are not run while it reports OperationResultDeleting.
+	WaitForDeletion bool
 }
 
 // Object returns the ObjectSyncer subject.
@@ -47,12 +71,26 @@ func (s *RemoveResourceSyncer) ObjectOwner() runtime.Object {
 
 // Sync does the actual syncing and implements the syncer.Inteface Sync method.
 func (s *RemoveResourceSyncer) Sync(ctx context.Context) (SyncResult, error) {
+	start := time.Now()
+	result, err := s.sync(ctx)
+	observeSync(ctx, s.Name, objectType(s.Obj, s.Client), result.Operation, err, start)
+
+	return result, err
+}
+
+func (s *RemoveResourceSyncer) sync(ctx context.Context) (SyncResult, error) {
 	result := SyncResult{}
 	log := logf.FromContext(ctx, "syncer", s.Name)
 	key := client.ObjectKeyFromObject(s.Obj)
 
 	result.Operation = controllerutil.OperationResultNone
 
+	if isPaused(s.Owner) {
+		setPaused(ctx, &result, s.Name, fmt.Sprintf("%s %s", objectType(s.Obj, s.Client), key))
+
+		return result, nil
+	}
+
 	// fetch the resource
 	if err := s.Client.Get(ctx, key, s.Obj); err != nil {
 		if k8serrors.IsNotFound(err) {
@@ -64,21 +102,84 @@ func (s *RemoveResourceSyncer) Sync(ctx context.Context) (SyncResult, error) {
 		return result, fmt.Errorf("error when fetching resource: %w", err)
 	}
 
+	if isPaused(s.Obj) {
+		setPaused(ctx, &result, s.Name, fmt.Sprintf("%s %s", objectType(s.Obj, s.Client), key))
+
+		return result, nil
+	}
+
+	// the deletion was already requested, wait for the finalizers
+	if s.WaitForDeletion && !s.Obj.GetDeletionTimestamp().IsZero() {
+		result.Operation = OperationResultDeleting
+
+		log.V(1).Info(string(result.Operation), "key", key, "kind", objectType(s.Obj, s.Client), "finalizers", s.Obj.GetFinalizers())
+
+		return result, nil
+	}
+
 	// delete the resource
-	if err := s.Client.Delete(ctx, s.Obj); err != nil {
+	if err := clientFor(ctx, s.Client).Delete(ctx, s.Obj, s.deleteOptions()...); client.IgnoreNotFound(err) != nil {
 		log.Error(err, string(result.Operation), "key", key, "kind", objectType(s.Obj, s.Client))
 
 		return result, fmt.Errorf("error when deleting resource: %w", err)
 	}
 
-	result.Operation = controllerutil.OperationResult("deleted")
-	result.SetEventData(eventNormal, basicEventReason(s.Name, nil), fmt.Sprintf("%s %s successfully deleted", objectType(s.Obj, s.Client), key))
+	gone, err := s.gone(ctx, key)
+	if err != nil {
+		log.Error(err, string(result.Operation), "key", key, "kind", objectType(s.Obj, s.Client))
+
+		return result, fmt.Errorf("error when fetching resource: %w", err)
+	}
+
+	if gone {
+		result.Operation = OperationResultDeleted
+		result.SetEventData(eventNormal, basicEventReason(s.Name, nil), fmt.Sprintf("%s %s successfully deleted", objectType(s.Obj, s.Client), key))
+	} else {
+		result.Operation = OperationResultDeleting
+		result.SetEventData(eventNormal, basicEventReason(s.Name, nil), fmt.Sprintf("%s %s is being deleted", objectType(s.Obj, s.Client), key))
+	}
 
 	log.V(1).Info(string(result.Operation), "key", key, "kind", objectType(s.Obj, s.Client))
 
 	return result, nil
 }
 
+// gone returns true when the object is no longer persisted. It always returns
+// true unless the syncer waits for deletion.
+func (s *RemoveResourceSyncer) gone(ctx context.Context, key client.ObjectKey) (bool, error) {
+	if !s.WaitForDeletion || IsDryRun(ctx) {
+		return true, nil
+	}
+
+	if err := s.Client.Get(ctx, key, s.Obj); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	return false, nil
+}
+
+func (s *RemoveResourceSyncer) deleteOptions() []client.DeleteOption {
+	opts := []client.DeleteOption{}
+
+	if s.PropagationPolicy != nil {
+		opts = append(opts, client.PropagationPolicy(*s.PropagationPolicy))
+	}
+
+	if s.GracePeriodSeconds != nil {
+		opts = append(opts, client.GracePeriodSeconds(*s.GracePeriodSeconds))
+	}
+
+	if s.Preconditions != nil {
+		opts = append(opts, client.Preconditions(*s.Preconditions))
+	}
+
+	return opts
+}
+
 // NewRemoveResourceSyncer creates a new kubernetes.Object syncer for a given object
 // with an owner and persists data using controller-runtime's Delete.
 // The name is used for logging and event emitting purposes and should be an
@@ -30,6 +30,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"github.com/presslabs/controller-util/pkg/syncer"
 )
@@ -111,6 +112,79 @@ var _ = Describe("ObjectSyncer", func() {
 			Consistently(recorder.Events).ShouldNot(Receive())
 		})
 
+		It("does not delete the resource in dry-run mode", func() {
+			dryRunSyncer := syncer.NewRemoveResourceSyncer("test-remove-resource-syncer", owner, deployment, c)
+
+			Expect(syncer.Sync(syncer.WithDryRun(context.TODO()), dryRunSyncer, recorder)).To(Succeed())
+			Expect(c.Get(context.TODO(), key, deployment)).To(Succeed())
+
+			Consistently(recorder.Events).ShouldNot(Receive())
+		})
+
+		It("does not delete the resource while it is paused", func() {
+			deployment.Annotations = map[string]string{syncer.PauseAnnotation: "true"}
+			Expect(c.Update(context.TODO(), deployment)).To(Succeed())
+
+			removeSyncer := syncer.NewRemoveResourceSyncer("test-remove-resource-syncer", owner, deployment, c)
+
+			result, err := removeSyncer.Sync(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Operation).To(Equal(syncer.OperationResultPaused))
+			Expect(c.Get(context.TODO(), key, deployment)).To(Succeed())
+
+			Expect(syncer.Sync(context.TODO(), removeSyncer, recorder)).To(Succeed())
+			Expect(<-recorder.Events).To(HavePrefix("Normal TestRemoveResourceSyncerPaused"))
+			Consistently(recorder.Events).ShouldNot(Receive())
+		})
+
+		It("waits for the finalizers when waiting for deletion", func() {
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       key.Name + "-finalized",
+					Namespace:  key.Namespace,
+					Finalizers: []string{"example.com/finalizer"},
+				},
+			}
+			Expect(c.Create(context.TODO(), cm)).To(Succeed())
+
+			waitSyncer := &syncer.RemoveResourceSyncer{
+				Name:            "test-remove-resource-syncer",
+				Owner:           owner,
+				Obj:             cm,
+				Client:          c,
+				WaitForDeletion: true,
+			}
+
+			result, err := waitSyncer.Sync(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Operation).To(Equal(syncer.OperationResultDeleting))
+
+			result, err = waitSyncer.Sync(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Operation).To(Equal(syncer.OperationResultDeleting))
+
+			cm.Finalizers = nil
+			Expect(c.Update(context.TODO(), cm)).To(Succeed())
+
+			result, err = waitSyncer.Sync(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Operation).To(Equal(controllerutil.OperationResultNone))
+		})
+
+		It("does not delete the resource when the preconditions fail", func() {
+			uid := types.UID("not-the-deployment-uid")
+			preconditionSyncer := &syncer.RemoveResourceSyncer{
+				Name:          "test-remove-resource-syncer",
+				Owner:         owner,
+				Obj:           deployment,
+				Client:        c,
+				Preconditions: &metav1.Preconditions{UID: &uid},
+			}
+
+			Expect(syncer.Sync(context.TODO(), preconditionSyncer, recorder)).NotTo(Succeed())
+			Expect(c.Get(context.TODO(), key, deployment)).To(Succeed())
+		})
+
 		It("skip deleting when the resource is lready deleted", func() {
 			var convOk bool
 
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// StatusSyncer is a syncer.Interface for syncing the status subresource of
+// kubernetes.Objects by passing a MutateFn. Events are recorded on the
+// object itself.
+type StatusSyncer struct {
+	Obj      client.Object
+	MutateFn controllerutil.MutateFn
+	Name     string
+	Client   client.Client
+
+	// ConflictRetry enables retrying the sync, re-reading the object and
+	// re-running the MutateFn, when it fails with a conflict error.
+	// retry.DefaultRetry is a sensible default.
+	ConflictRetry *wait.Backoff
+	// APIReader is an uncached reader (eg. manager.GetAPIReader()) used for
+	// re-reading the object when retrying conflicts. Defaults to the Client.
+	APIReader client.Reader
+}
+
+// Object returns the StatusSyncer subject.
+func (s *StatusSyncer) Object() interface{} {
+	return s.Obj
+}
+
+// ObjectOwner returns the StatusSyncer subject, as events are recorded on it.
+func (s *StatusSyncer) ObjectOwner() runtime.Object {
+	return s.Obj
+}
+
+// Sync does the actual syncing and implements the syncer.Inteface Sync method.
+func (s *StatusSyncer) Sync(ctx context.Context) (SyncResult, error) {
+	var err error
+
+	result := SyncResult{}
+	log := logf.FromContext(ctx, "syncer", s.Name)
+	key := client.ObjectKeyFromObject(s.Obj)
+
+	start := time.Now()
+	result.Operation, result.Attempts, err = retryOnConflict(ctx, s.ConflictRetry, s.Obj, s.updateStatus)
+	observeSync(ctx, s.Name, objectType(s.Obj, s.Client), result.Operation, err, start)
+
+	//nolint: gocritic
+	if errors.Is(err, ErrIgnore) {
+		log.V(1).Info("syncer skipped", "key", key, "kind", objectType(s.Obj, s.Client), "error", err)
+		err = nil
+	} else if err != nil {
+		result.SetEventData(eventWarning, basicEventReason(s.Name, err),
+			fmt.Sprintf("%s %s failed syncing status: %s", objectType(s.Obj, s.Client), key, err))
+		log.Error(err, string(result.Operation), "key", key, "kind", objectType(s.Obj, s.Client))
+	} else if result.Operation == OperationResultPaused {
+		setPaused(ctx, &result, s.Name, fmt.Sprintf("%s %s status", objectType(s.Obj, s.Client), key))
+	} else {
+		result.SetEventData(eventNormal, basicEventReason(s.Name, err),
+			fmt.Sprintf("%s %s %s successfully", objectType(s.Obj, s.Client), key, result.Operation))
+		log.V(1).Info(string(result.Operation), "key", key, "kind", objectType(s.Obj, s.Client))
+	}
+
+	return result, err
+}
+
+// writesShared returns true, as the object is read into the subject, which is
+// usually the owner of other syncers.
+func (s *StatusSyncer) writesShared() bool {
+	return true
+}
+
+// updateStatus fetches the object, calls the MutateFn and updates the status
+// subresource if the status changed.
+func (s *StatusSyncer) updateStatus(ctx context.Context) (controllerutil.OperationResult, error) {
+	key := client.ObjectKeyFromObject(s.Obj)
+
+	if err := retryClient(ctx, s.Client, s.APIReader).Get(ctx, key, s.Obj); err != nil {
+		if k8serrors.IsNotFound(err) {
+			// the object is gone, there is no status to update
+			return controllerutil.OperationResultNone, IgnoredError(err)
+		}
+
+		return controllerutil.OperationResultNone, err
+	}
+
+	if isPaused(s.Obj) {
+		return OperationResultPaused, nil
+	}
+
+	_, before, err := splitStatus(s.Obj)
+	if err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	if err := s.MutateFn(); err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	_, after, err := splitStatus(s.Obj)
+	if err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	if reflect.DeepEqual(before, after) {
+		return controllerutil.OperationResultNone, nil
+	}
+
+	if err := clientFor(ctx, s.Client).Status().Update(ctx, s.Obj); err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	return controllerutil.OperationResultUpdatedStatusOnly, nil
+}
+
+// NewStatusSyncer creates a new syncer which updates only the status
+// subresource of the given object, using the mutateFn to set the status. The
+// update is skipped when the status didn't change. The name is used for
+// logging and event emitting purposes and should be an valid go identifier in
+// upper camel case. (eg. MysqlClusterStatus).
+func NewStatusSyncer(name string, obj client.Object, c client.Client, mutateFn controllerutil.MutateFn) Interface {
+	return &StatusSyncer{
+		Obj:      obj,
+		MutateFn: mutateFn,
+		Name:     name,
+		Client:   c,
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/presslabs/controller-util/pkg/syncer"
+)
+
+var _ = Describe("StatusSyncer", func() {
+	var (
+		recorder   *record.FakeRecorder
+		deployment *appsv1.Deployment
+		key        types.NamespacedName
+		replicas   int32
+	)
+
+	newSyncer := func() syncer.Interface {
+		obj := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+		}
+
+		return syncer.NewStatusSyncer("ExampleStatus", obj, c, func() error {
+			obj.Status.Replicas = replicas
+
+			return nil
+		})
+	}
+
+	BeforeEach(func() {
+		r := rand.Int31() //nolint: gosec
+
+		key = types.NamespacedName{
+			Name:      fmt.Sprintf("example-%d", r),
+			Namespace: fmt.Sprintf("default-%d", r),
+		}
+		replicas = 1
+		recorder = record.NewFakeRecorder(100)
+
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: key.Namespace,
+			},
+		}
+		Expect(c.Create(context.TODO(), ns)).To(Succeed())
+
+		deployment = &appsv1.Deployment{}
+		Expect(NewDeploymentSyncer(nil, key).Sync(context.TODO())).Error().NotTo(HaveOccurred())
+	})
+
+	It("updates only the status when it changes", func() {
+		Expect(syncer.Sync(context.TODO(), newSyncer(), recorder)).To(Succeed())
+
+		Expect(c.Get(context.TODO(), key, deployment)).To(Succeed())
+		Expect(deployment.Status.Replicas).To(Equal(int32(1)))
+
+		var event string
+		Expect(recorder.Events).To(Receive(&event))
+		Expect(event).To(ContainSubstring("ExampleStatusSyncSuccessfull"))
+
+		result, err := newSyncer().Sync(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Operation).To(Equal(controllerutil.OperationResultNone))
+
+		replicas = 2
+		result, err = newSyncer().Sync(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Operation).To(Equal(controllerutil.OperationResultUpdatedStatusOnly))
+
+		Expect(c.Get(context.TODO(), key, deployment)).To(Succeed())
+		Expect(deployment.Status.Replicas).To(Equal(int32(2)))
+	})
+})
@@ -20,11 +20,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 
 	"github.com/iancoleman/strcase"
-	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -49,32 +53,23 @@ func IgnoredError(err error) error {
 	return fmt.Errorf("%w: %w", err, ErrIgnore)
 }
 
+// EventReason returns the reason of the events recorded for the syncer with
+// the given name, depending on the sync error.
+func EventReason(name string, err error) string {
+	return basicEventReason(name, err)
+}
+
 func basicEventReason(objKindName string, err error) string {
 	if err != nil {
 		return strcase.ToCamel(objKindName) + "SyncFailed"
 	}
 
-	return strcase.ToCamel(objKindName) + "SyncSuccessfull"
+	return strcase.ToCamel(objKindName) + legacySuccessReason
 }
 
 // Redacts sensitive data from runtime.Object making them suitable for logging.
 func redact(obj runtime.Object) runtime.Object {
-	switch exposed := obj.(type) {
-	case *corev1.Secret:
-		redacted := exposed.DeepCopy()
-		redacted.Data = nil
-		redacted.StringData = nil
-		exposed.ObjectMeta.DeepCopyInto(&redacted.ObjectMeta)
-
-		return redacted
-	case *corev1.ConfigMap:
-		redacted := exposed.DeepCopy()
-		redacted.Data = nil
-
-		return redacted
-	}
-
-	return obj
+	return DefaultRedactor.Redact(obj, nil)
 }
 
 // objectType returns the type of a runtime.Object.
@@ -91,20 +86,149 @@ func objectType(obj runtime.Object, c client.Client) string {
 	return "nil"
 }
 
+// deepCopy returns a deep copy of a client.Object.
+func deepCopy(obj client.Object) client.Object {
+	return obj.DeepCopyObject().(client.Object) //nolint: forcetypeassert
+}
+
+// restore overwrites obj in place with a deep copy of from, which must have
+// the same type.
+func restore(obj, from client.Object) {
+	reflect.ValueOf(obj).Elem().Set(reflect.ValueOf(from.DeepCopyObject()).Elem())
+}
+
+// retryOnConflict calls fn, retrying it with the given backoff when it fails
+// with a conflict error. Server-side apply field ownership conflicts are not
+// retried, as they persist until the fields are released or taken over.
+// Before each retry obj is restored to its initial state. The retries read
+// the object using retryClient. It returns the number of attempts made.
+func retryOnConflict(
+	ctx context.Context, backoff *wait.Backoff, obj client.Object, fn func(context.Context) (controllerutil.OperationResult, error),
+) (controllerutil.OperationResult, int, error) {
+	if backoff == nil {
+		op, err := fn(ctx)
+
+		return op, 1, err
+	}
+
+	var op controllerutil.OperationResult
+
+	initial := deepCopy(obj)
+	attempts := 0
+
+	err := retry.OnError(*backoff, isRetriableConflict, func() error {
+		attemptCtx := ctx
+
+		if attempts > 0 {
+			// start over from the object passed to the syncer
+			restore(obj, initial)
+
+			attemptCtx = context.WithValue(ctx, retryKey{}, true)
+		}
+
+		attempts++
+
+		var err error
+
+		op, err = fn(attemptCtx)
+
+		return err
+	})
+
+	return op, attempts, err
+}
+
+type retryKey struct{}
+
+// isRetry returns true when the context is of a retried sync attempt.
+func isRetry(ctx context.Context) bool {
+	retried, _ := ctx.Value(retryKey{}).(bool)
+
+	return retried
+}
+
+// retryClient returns a client which reads through the reader, when the sync
+// is retried and the reader is set, and the given client otherwise. The
+// cached clients usually return the same stale object right after a
+// conflict, so the retries would fail again.
+func retryClient(ctx context.Context, c client.Client, reader client.Reader) client.Client {
+	if reader == nil || !isRetry(ctx) {
+		return c
+	}
+
+	return &uncachedClient{Client: c, reader: reader}
+}
+
+// uncachedClient is a client.Client reading through an uncached reader.
+type uncachedClient struct {
+	client.Client
+
+	reader client.Reader
+}
+
+func (c *uncachedClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	return c.reader.Get(ctx, key, obj, opts...)
+}
+
+func (c *uncachedClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return c.reader.List(ctx, list, opts...)
+}
+
+// isRetriableConflict returns true for the conflict errors caused by a stale
+// object, which may succeed when retried on the latest version.
+func isRetriableConflict(err error) bool {
+	if !k8serrors.IsConflict(err) {
+		return false
+	}
+
+	var status k8serrors.APIStatus
+	if errors.As(err, &status) && status.Status().Details != nil {
+		for _, cause := range status.Status().Details.Causes {
+			if cause.Type == metav1.CauseTypeFieldManagerConflict {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 // Sync mutates the subject of the syncer interface using controller-runtime
 // CreateOrUpdate method, when obj is not nil. It takes care of setting owner
 // references and recording kubernetes events where appropriate.
 func Sync(ctx context.Context, syncer Interface, recorder record.EventRecorder) error {
+	_, err := syncAndRecord(ctx, syncer, legacyEvents(recorder))
+
+	return err
+}
+
+// syncAndRecord runs the syncer and records the resulting event.
+func syncAndRecord(ctx context.Context, syncer Interface, sink eventSink) (SyncResult, error) {
 	result, err := syncer.Sync(ctx)
 	owner := syncer.ObjectOwner()
 
-	if recorder != nil && owner != nil && result.EventType != "" && result.EventReason != "" && result.EventMessage != "" {
+	if sink == nil || owner == nil || IsDryRun(ctx) {
+		return result, err
+	}
+
+	if tracker, ok := sink.(pauseTracker); ok && !tracker.pauseChanged(pausedKey(syncer, owner), result) {
+		return result, err
+	}
+
+	action := eventAction(result.Operation)
+
+	for _, event := range result.Events {
+		sink.event(owner, event.Related, event.Type, event.Reason, action, event.Message)
+	}
+
+	if result.EventType != "" && result.EventReason != "" && result.EventMessage != "" {
 		if err != nil || result.Operation != controllerutil.OperationResultNone {
-			recorder.Eventf(owner, result.EventType, result.EventReason, result.EventMessage)
+			related, _ := syncer.Object().(runtime.Object)
+			sink.event(owner, related, result.EventType, result.EventReason, action, result.EventMessage)
 		}
 	}
 
-	return err
+	return result, err
 }
 
 // WithoutOwner partially implements implements the syncer interface for the
@@ -17,11 +17,24 @@ limitations under the License.
 package syncer
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/presslabs/controller-util/pkg/meta"
 )
 
 var _ = Describe("redact function", func() {
@@ -68,3 +81,204 @@ var _ = Describe("redact function", func() {
 		Expect(obj.Data).To(HaveKey("awesome-secret-key"))
 	})
 })
+
+var _ = Describe("Redactor", func() {
+	It("redacts the environment variables values of workloads", func() {
+		obj := &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: "awesome-container",
+								Env: []corev1.EnvVar{
+									{Name: "PASSWORD", Value: "secret"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		redacted, ok := redact(obj).(*appsv1.Deployment)
+		Expect(ok).To(BeTrue())
+		Expect(redacted.Spec.Template.Spec.Containers[0].Env).To(Equal([]corev1.EnvVar{{Name: "PASSWORD"}}))
+		Expect(obj.Spec.Template.Spec.Containers[0].Env[0].Value).To(Equal("secret"))
+	})
+
+	It("redacts the fields listed in the redact annotation", func() {
+		obj := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "awesome-pod",
+				Annotations: map[string]string{RedactAnnotation: "spec.containers[].args"},
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name: "awesome-container",
+						Args: []string{"--password=secret"},
+					},
+				},
+			},
+		}
+
+		redacted, ok := redact(obj).(*corev1.Pod)
+		Expect(ok).To(BeTrue())
+		Expect(redacted.Spec.Containers[0].Args).To(BeEmpty())
+		Expect(redacted.Spec.Containers[0].Name).To(Equal("awesome-container"))
+	})
+
+	It("keeps the default rules when a syncer uses a custom redactor", func() {
+		redactor := NewRedactor()
+		redactor.Register(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, "metadata.labels")
+
+		s := &ObjectSyncer{Client: fake.NewClientBuilder().Build(), Redactor: redactor}
+		obj := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "example", Labels: map[string]string{"app": "example"}},
+			Data:       map[string]string{"password": "secret"},
+		}
+
+		redacted, ok := s.redact(obj).(*corev1.ConfigMap)
+		Expect(ok).To(BeTrue())
+		Expect(redacted.Data).To(BeEmpty())
+		Expect(redacted.Labels).To(BeEmpty())
+	})
+
+	It("redacts unstructured objects using the registered rules", func() {
+		gvk := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Database"}
+
+		redactor := NewRedactor()
+		redactor.Register(gvk, "spec.users.*.password")
+
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+		Expect(unstructured.SetNestedField(obj.Object, "secret", "spec", "users", "admin", "password")).To(Succeed())
+		Expect(unstructured.SetNestedField(obj.Object, "admin", "spec", "users", "admin", "name")).To(Succeed())
+
+		redacted, ok := redactor.Redact(obj, nil).(*unstructured.Unstructured)
+		Expect(ok).To(BeTrue())
+		Expect(redacted.Object).To(HaveKeyWithValue("spec", map[string]interface{}{
+			"users": map[string]interface{}{"admin": map[string]interface{}{"name": "admin"}},
+		}))
+	})
+})
+
+var _ = Describe("fieldChanges function", func() {
+	It("returns the changed fields with their old and new values", func() {
+		before := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "awesome-pod",
+				ResourceVersion: "1",
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:  "awesome-container",
+						Image: "busybox",
+					},
+				},
+			},
+		}
+
+		after := before.DeepCopy()
+		after.ResourceVersion = "2"
+		after.Labels = map[string]string{"app.kubernetes.io/name": "awesome"}
+		after.Spec.Containers[0].Image = "busybox:latest"
+
+		Expect(fieldChanges(before, after)).To(Equal([]FieldChange{
+			{Path: "metadata.labels[app.kubernetes.io/name]", Old: nil, New: "awesome"},
+			{Path: "spec.containers[0].image", Old: "busybox", New: "busybox:latest"},
+		}))
+	})
+
+	It("doesn't return secret data", func() {
+		before := &corev1.Secret{
+			Data: map[string][]byte{"password": []byte("old")},
+		}
+		after := &corev1.Secret{
+			Data: map[string][]byte{"password": []byte("new")},
+		}
+
+		Expect(fieldChanges(redact(before), redact(after))).To(BeEmpty())
+	})
+})
+
+var _ = Describe("retryOnConflict", func() {
+	backoff := wait.Backoff{Steps: 3}
+	obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "example", Namespace: "default"}}
+
+	retried := func(err error) int {
+		_, attempts, _ := retryOnConflict(context.TODO(), &backoff, obj, func(context.Context) (controllerutil.OperationResult, error) {
+			return controllerutil.OperationResultNone, err
+		})
+
+		return attempts
+	}
+
+	It("retries the conflicts caused by stale objects", func() {
+		Expect(retried(k8serrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "example", nil))).To(Equal(3))
+	})
+
+	It("doesn't retry server-side apply field ownership conflicts", func() {
+		err := k8serrors.NewApplyConflict([]metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldManagerConflict,
+			Message: `conflict with "other-manager"`,
+			Field:   ".data.key",
+		}}, `Apply failed with 1 conflict: conflict with "other-manager": .data.key`)
+
+		Expect(retried(err)).To(Equal(1))
+	})
+
+	It("re-reads the object using the APIReader when retrying", func() {
+		reads := 0
+		cached := fake.NewClientBuilder().WithObjects(obj.DeepCopy()).Build()
+		uncached := fake.NewClientBuilder().WithObjects(obj.DeepCopy()).WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, o client.Object, opts ...client.GetOption) error {
+				reads++
+
+				return c.Get(ctx, key, o, opts...)
+			},
+		}).Build()
+
+		_, attempts, _ := retryOnConflict(context.TODO(), &backoff, obj, func(ctx context.Context) (controllerutil.OperationResult, error) {
+			Expect(retryClient(ctx, cached, uncached).Get(ctx, client.ObjectKeyFromObject(obj), &corev1.ConfigMap{})).To(Succeed())
+
+			return controllerutil.OperationResultNone, k8serrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "example", nil)
+		})
+
+		Expect(attempts).To(Equal(3))
+		Expect(reads).To(Equal(2))
+	})
+})
+
+var _ = Describe("updateOwnerFinalizer", func() {
+	var (
+		c     client.Client
+		owner *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		owner = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: "default"}}
+		c = fake.NewClientBuilder().WithObjects(owner.DeepCopy()).Build()
+		Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(owner), owner)).To(Succeed())
+	})
+
+	It("sets the finalizer on the owner once the patch succeeded", func() {
+		resourceVersion := owner.ResourceVersion
+
+		Expect(updateOwnerFinalizer(context.TODO(), c, owner, "example.com/finalizer", meta.AddObjectFinalizer)).To(Succeed())
+		Expect(owner.Finalizers).To(ConsistOf("example.com/finalizer"))
+		Expect(owner.ResourceVersion).NotTo(Equal(resourceVersion))
+	})
+
+	It("leaves the owner unchanged when the patch fails", func() {
+		stale := owner.DeepCopy()
+		owner.Labels = map[string]string{"changed": "true"}
+		Expect(c.Update(context.TODO(), owner)).To(Succeed())
+
+		Expect(updateOwnerFinalizer(context.TODO(), c, stale, "example.com/finalizer", meta.AddObjectFinalizer)).NotTo(Succeed())
+		Expect(stale.Finalizers).To(BeEmpty())
+		Expect(stale.ResourceVersion).NotTo(Equal(owner.ResourceVersion))
+	})
+})
@@ -0,0 +1,283 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/presslabs/controller-util/pkg/meta"
+)
+
+var (
+	// errNoClient is returned when the syncer manages a finalizer without a client.
+	errNoClient = errors.New("a client is required for managing the owner finalizer")
+	// errNoEqual is returned when the syncer has no Equal hook.
+	errNoEqual = errors.New("the Equal hook is required")
+)
+
+// ExternalHooks are the functions used by a TypedExternalSyncer to observe
+// and persist the state of an external resource.
+type ExternalHooks[T any] struct {
+	// Get observes the external resource. It returns false when the resource
+	// does not exist.
+	Get func(ctx context.Context) (T, bool, error)
+	// Create creates the external resource and returns its observed state.
+	Create func(ctx context.Context, desired T) (T, error)
+	// Update updates the external resource and returns its observed state.
+	Update func(ctx context.Context, observed, desired T) (T, error)
+	// Delete deletes the external resource. It is optional and it is called
+	// when the owner is being deleted (and while it has the finalizer, if the
+	// syncer manages one).
+	Delete func(ctx context.Context, observed T) error
+	// Equal returns true when the observed state matches the desired one. It
+	// is required and it should compare only the fields managed by the
+	// syncer, as the observed state usually has fields the desired one never
+	// sets (eg. IDs or timestamps assigned by the external store).
+	Equal func(observed, desired T) bool
+}
+
+// TypedExternalSyncer is a syncer.TypedInterface for syncing resources
+// persisted into an external store. The syncer observes the resource and
+// decides whether to create or update it by comparing the observed state with
+// the desired one. The hooks which persist changes are not called in dry-run
+// mode (see WithDryRun).
+type TypedExternalSyncer[T any] struct {
+	ExternalHooks[T]
+
+	Name    string
+	Owner   client.Object
+	Desired T
+
+	// Finalizer is added to the owner to prevent its deletion until the
+	// external resource is deleted. It is removed from the owner only after
+	// the Delete hook succeeded. Requires Client to be set.
+	Finalizer string
+	// Client is used for updating the owner finalizers.
+	Client client.Client
+
+	// Observed holds the state of the external resource after the last sync.
+	// It is set on the SyncResult too.
+	Observed T
+}
+
+// Object returns the TypedExternalSyncer subject, the desired state.
+func (s *TypedExternalSyncer[T]) Object() T {
+	return s.Desired
+}
+
+// writesShared returns true when the syncer manages the owner finalizer.
+func (s *TypedExternalSyncer[T]) writesShared() bool {
+	return s.Finalizer != ""
+}
+
+// ObjectOwner returns the TypedExternalSyncer owner.
+func (s *TypedExternalSyncer[T]) ObjectOwner() runtime.Object {
+	if s.Owner == nil {
+		return nil
+	}
+
+	return s.Owner
+}
+
+// ObjectType returns the type of the TypedExternalSyncer subject.
+func (s *TypedExternalSyncer[T]) ObjectType() string {
+	return fmt.Sprintf("%T", s.Desired)
+}
+
+// Sync does the actual syncing and implements the syncer.Inteface Sync method.
+func (s *TypedExternalSyncer[T]) Sync(ctx context.Context) (SyncResult, error) {
+	var err error
+
+	log := logf.FromContext(ctx, "syncer", s.Name)
+
+	result := SyncResult{}
+	start := time.Now()
+
+	if isPaused(s.Owner) || isPaused(s.Desired) {
+		result.Operation = OperationResultPaused
+	} else {
+		result.Operation, err = s.sync(ctx)
+	}
+
+	observeSync(ctx, s.Name, s.ObjectType(), result.Operation, err, start)
+
+	result.Observed = s.Observed
+
+	switch {
+	case err != nil:
+		result.SetEventData(eventWarning, basicEventReason(s.Name, err),
+			fmt.Sprintf("%s failed syncing: %s", s.ObjectType(), err))
+		log.Error(err, string(result.Operation), "kind", s.ObjectType())
+	case result.Operation == OperationResultPaused:
+		setPaused(ctx, &result, s.Name, s.ObjectType())
+	default:
+		result.SetEventData(eventNormal, basicEventReason(s.Name, err),
+			fmt.Sprintf("%s successfully %s", s.ObjectType(), result.Operation))
+		log.V(1).Info(string(result.Operation), "kind", s.ObjectType())
+	}
+
+	return result, err
+}
+
+func (s *TypedExternalSyncer[T]) sync(ctx context.Context) (controllerutil.OperationResult, error) {
+	if s.Owner != nil && !s.Owner.GetDeletionTimestamp().IsZero() {
+		return s.delete(ctx)
+	}
+
+	if s.Equal == nil {
+		return controllerutil.OperationResultNone, errNoEqual
+	}
+
+	observed, found, err := s.Get(ctx)
+	if err != nil {
+		return controllerutil.OperationResultNone, fmt.Errorf("error when observing resource: %w", err)
+	}
+
+	s.Observed = observed
+
+	if err := s.updateFinalizer(ctx, meta.AddObjectFinalizer); err != nil {
+		return controllerutil.OperationResultNone, fmt.Errorf("error when adding finalizer: %w", err)
+	}
+
+	switch {
+	case !found:
+		if IsDryRun(ctx) {
+			return controllerutil.OperationResultCreated, nil
+		}
+
+		if s.Observed, err = s.Create(ctx, s.Desired); err != nil {
+			return controllerutil.OperationResultNone, fmt.Errorf("error when creating resource: %w", err)
+		}
+
+		return controllerutil.OperationResultCreated, nil
+	case s.Equal(observed, s.Desired):
+		return controllerutil.OperationResultNone, nil
+	default:
+		if IsDryRun(ctx) {
+			return controllerutil.OperationResultUpdated, nil
+		}
+
+		if s.Observed, err = s.Update(ctx, observed, s.Desired); err != nil {
+			return controllerutil.OperationResultNone, fmt.Errorf("error when updating resource: %w", err)
+		}
+
+		return controllerutil.OperationResultUpdated, nil
+	}
+}
+
+// delete deletes the external resource and then removes the owner finalizer.
+func (s *TypedExternalSyncer[T]) delete(ctx context.Context) (controllerutil.OperationResult, error) {
+	var zero T
+
+	if s.Finalizer != "" && !meta.HasObjectFinalizer(s.Owner, s.Finalizer) {
+		// the external resource was already deleted
+		return controllerutil.OperationResultNone, nil
+	}
+
+	result := controllerutil.OperationResultNone
+
+	observed, found, err := s.Get(ctx)
+	if err != nil {
+		return result, fmt.Errorf("error when observing resource: %w", err)
+	}
+
+	s.Observed = observed
+
+	if found && s.Delete != nil {
+		if !IsDryRun(ctx) {
+			if err := s.Delete(ctx, observed); err != nil {
+				return result, fmt.Errorf("error when deleting resource: %w", err)
+			}
+		}
+
+		s.Observed = zero
+		result = OperationResultDeleted
+	}
+
+	if err := s.updateFinalizer(ctx, meta.RemoveObjectFinalizer); err != nil {
+		return result, fmt.Errorf("error when removing finalizer: %w", err)
+	}
+
+	return result, nil
+}
+
+// updateFinalizer adds or removes the syncer finalizer on the owner, using
+// the given pkg/meta function.
+func (s *TypedExternalSyncer[T]) updateFinalizer(ctx context.Context, update func(metav1.Object, string) bool) error {
+	if s.Owner == nil {
+		return nil
+	}
+
+	return updateOwnerFinalizer(ctx, s.Client, s.Owner, s.Finalizer, update)
+}
+
+// updateOwnerFinalizer adds or removes the finalizer on the owner, using the
+// given pkg/meta function, and patches the owner if it changed. The patch is
+// sent using a copy of the owner and only the finalizers and the resource
+// version are set back on the owner, once the patch succeeded. Within a Group
+// the syncers managing the owner finalizer run alone, as they write to the
+// owner. Nothing is changed in dry-run mode.
+func updateOwnerFinalizer(
+	ctx context.Context, c client.Client, owner client.Object, finalizer string, update func(metav1.Object, string) bool,
+) error {
+	if finalizer == "" || IsDryRun(ctx) {
+		return nil
+	}
+
+	if c == nil {
+		return errNoClient
+	}
+
+	before := deepCopy(owner)
+	patched := deepCopy(owner)
+
+	if !update(patched, finalizer) {
+		return nil
+	}
+
+	if err := c.Patch(ctx, patched, client.MergeFromWithOptions(before, client.MergeFromWithOptimisticLock{})); err != nil {
+		return err
+	}
+
+	owner.SetFinalizers(patched.GetFinalizers())
+	owner.SetResourceVersion(patched.GetResourceVersion())
+
+	return nil
+}
+
+// NewTypedExternalSyncer creates a new syncer which syncs the desired state of
+// a resource persisted into an external store, using the given hooks. The
+// name is used for logging and event emitting purposes and should be an valid
+// go identifier in upper camel case. (eg. GiteaRepo). The Equal hook is
+// required.
+func NewTypedExternalSyncer[T any](name string, owner client.Object, desired T, hooks ExternalHooks[T]) TypedInterface[T] {
+	return &TypedExternalSyncer[T]{
+		ExternalHooks: hooks,
+		Name:          name,
+		Owner:         owner,
+		Desired:       desired,
+	}
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/presslabs/controller-util/pkg/syncer"
+)
+
+type repo struct {
+	ID          int
+	Name        string
+	Description string
+}
+
+var _ = Describe("TypedExternalSyncer", func() {
+	var (
+		owner     *corev1.ConfigMap
+		store     map[string]repo
+		nextID    int
+		deleteErr error
+	)
+
+	newSyncer := func(desired repo) syncer.TypedInterface[repo] {
+		return syncer.NewTypedExternalSyncer("ExampleRepo", owner, desired, syncer.ExternalHooks[repo]{
+			Get: func(context.Context) (repo, bool, error) {
+				observed, found := store[desired.Name]
+
+				return observed, found, nil
+			},
+			Create: func(_ context.Context, desired repo) (repo, error) {
+				nextID++
+				desired.ID = nextID
+				store[desired.Name] = desired
+
+				return desired, nil
+			},
+			Update: func(_ context.Context, observed, desired repo) (repo, error) {
+				observed.Description = desired.Description
+				store[desired.Name] = observed
+
+				return observed, nil
+			},
+			Delete: func(_ context.Context, observed repo) error {
+				if deleteErr != nil {
+					return deleteErr
+				}
+
+				delete(store, observed.Name)
+
+				return nil
+			},
+			Equal: func(observed, desired repo) bool {
+				return observed.Description == desired.Description
+			},
+		})
+	}
+
+	BeforeEach(func() {
+		owner = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "owner",
+				Namespace: "default",
+			},
+		}
+		store = map[string]repo{}
+		nextID = 0
+		deleteErr = nil
+	})
+
+	It("creates, updates and leaves unchanged the external resource", func() {
+		result, err := newSyncer(repo{Name: "example", Description: "initial"}).Sync(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Operation).To(Equal(controllerutil.OperationResultCreated))
+		Expect(result.Observed).To(Equal(repo{ID: 1, Name: "example", Description: "initial"}))
+
+		result, err = newSyncer(repo{Name: "example", Description: "initial"}).Sync(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Operation).To(Equal(controllerutil.OperationResultNone))
+
+		repoSyncer, convOk := newSyncer(repo{Name: "example", Description: "changed"}).(*syncer.TypedExternalSyncer[repo])
+		Expect(convOk).To(BeTrue())
+
+		result, err = repoSyncer.Sync(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Operation).To(Equal(controllerutil.OperationResultUpdated))
+		Expect(repoSyncer.Observed).To(Equal(repo{ID: 1, Name: "example", Description: "changed"}))
+	})
+
+	It("fails without an Equal hook", func() {
+		repoSyncer, convOk := newSyncer(repo{Name: "example"}).(*syncer.TypedExternalSyncer[repo])
+		Expect(convOk).To(BeTrue())
+
+		repoSyncer.Equal = nil
+
+		result, err := repoSyncer.Sync(context.TODO())
+		Expect(err).To(MatchError(ContainSubstring("Equal hook is required")))
+		Expect(result.EventType).To(Equal("Warning"))
+		Expect(store).To(BeEmpty())
+	})
+
+	It("does not persist changes in dry-run mode", func() {
+		result, err := newSyncer(repo{Name: "example"}).Sync(syncer.WithDryRun(context.TODO()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Operation).To(Equal(controllerutil.OperationResultCreated))
+		Expect(store).To(BeEmpty())
+	})
+
+	It("does not persist changes while the owner is paused", func() {
+		owner.Annotations = map[string]string{syncer.PauseAnnotation: "true"}
+
+		result, err := newSyncer(repo{Name: "example"}).Sync(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Operation).To(Equal(syncer.OperationResultPaused))
+		Expect(result.EventType).To(Equal("Normal"))
+		Expect(result.EventReason).To(Equal("ExampleRepoPaused"))
+		Expect(store).To(BeEmpty())
+	})
+
+	It("deletes the external resource when the owner is deleted", func() {
+		Expect(newSyncer(repo{Name: "example"}).Sync(context.TODO())).Error().NotTo(HaveOccurred())
+
+		now := metav1.Now()
+		owner.DeletionTimestamp = &now
+
+		result, err := newSyncer(repo{Name: "example"}).Sync(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Operation).To(Equal(syncer.OperationResultDeleted))
+		Expect(store).To(BeEmpty())
+	})
+
+	When("managing the owner finalizer", func() {
+		var (
+			recorder *record.FakeRecorder
+			key      types.NamespacedName
+		)
+
+		newFinalizerSyncer := func() syncer.Interface {
+			repoSyncer, convOk := newSyncer(repo{Name: "example"}).(*syncer.TypedExternalSyncer[repo])
+			Expect(convOk).To(BeTrue())
+
+			repoSyncer.Finalizer = "example.com/repo"
+			repoSyncer.Client = c
+
+			return syncer.Untyped[repo](repoSyncer)
+		}
+
+		BeforeEach(func() {
+			r := rand.Int31() //nolint: gosec
+
+			key = types.NamespacedName{
+				Name:      fmt.Sprintf("example-%d", r),
+				Namespace: fmt.Sprintf("default-%d", r),
+			}
+			recorder = record.NewFakeRecorder(100)
+			owner.Name = key.Name
+			owner.Namespace = key.Namespace
+
+			Expect(c.Create(context.TODO(), &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: key.Namespace}})).To(Succeed())
+			Expect(c.Create(context.TODO(), owner)).To(Succeed())
+		})
+
+		It("deletes the external resource before releasing the owner", func() {
+			Expect(syncer.Sync(context.TODO(), newFinalizerSyncer(), recorder)).To(Succeed())
+			Expect(c.Get(context.TODO(), key, owner)).To(Succeed())
+			Expect(owner.Finalizers).To(ConsistOf("example.com/repo"))
+			Expect(store).To(HaveKey("example"))
+
+			Expect(c.Delete(context.TODO(), owner)).To(Succeed())
+			Expect(c.Get(context.TODO(), key, owner)).To(Succeed())
+
+			deleteErr = errSyncFailed
+			Expect(syncer.Sync(context.TODO(), newFinalizerSyncer(), recorder)).NotTo(Succeed())
+			Expect(c.Get(context.TODO(), key, owner)).To(Succeed())
+			Expect(owner.Finalizers).To(ConsistOf("example.com/repo"))
+
+			deleteErr = nil
+			Expect(syncer.Sync(context.TODO(), newFinalizerSyncer(), recorder)).To(Succeed())
+			Expect(store).To(BeEmpty())
+			Expect(k8serrors.IsNotFound(c.Get(context.TODO(), client.ObjectKeyFromObject(owner), &corev1.ConfigMap{}))).To(BeTrue())
+
+			Expect(<-recorder.Events).To(ContainSubstring("ExampleRepoSyncSuccessfull"))
+			Expect(<-recorder.Events).To(ContainSubstring("Warning ExampleRepoSyncFailed"))
+			Expect(<-recorder.Events).To(ContainSubstring("successfully deleted"))
+		})
+	})
+})
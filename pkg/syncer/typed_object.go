@@ -0,0 +1,57 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TypedObjectSyncer is a syncer.TypedInterface for syncing kubernetes.Objects
+// of a known type. It supports all the ObjectSyncer options.
+type TypedObjectSyncer[T client.Object] struct {
+	ObjectSyncer
+
+	obj T
+}
+
+// Object returns the TypedObjectSyncer subject.
+func (s *TypedObjectSyncer[T]) Object() T {
+	return s.obj
+}
+
+// NewTypedObjectSyncer creates a new kubernetes.Object syncer for a given
+// object with an owner and persists data using controller-runtime's
+// CreateOrUpdate. The syncFn receives the subject, populated with the
+// existing object data when it exists, and must set its desired state.
+// The name is used for logging and event emitting purposes and should be an
+// valid go identifier in upper camel case. (eg. MysqlStatefulSet).
+func NewTypedObjectSyncer[T client.Object](
+	name string, owner client.Object, obj T, c client.Client, syncFn func(existing T) error,
+) TypedInterface[T] {
+	return &TypedObjectSyncer[T]{
+		ObjectSyncer: ObjectSyncer{
+			Owner:  owner,
+			Obj:    obj,
+			Name:   name,
+			Client: c,
+			SyncFn: func() error {
+				return syncFn(obj)
+			},
+		},
+		obj: obj,
+	}
+}
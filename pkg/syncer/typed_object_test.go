@@ -0,0 +1,111 @@
+/*
+Copyright 2026 Pressinfra SRL.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/presslabs/controller-util/pkg/syncer"
+)
+
+var _ = Describe("TypedObjectSyncer", func() {
+	var (
+		recorder *record.FakeRecorder
+		owner    *corev1.ConfigMap
+		key      types.NamespacedName
+	)
+
+	newSyncer := func(value string) syncer.TypedInterface[*corev1.ConfigMap] {
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name + "-child",
+				Namespace: key.Namespace,
+			},
+		}
+
+		return syncer.NewTypedObjectSyncer("ExampleConfigMap", owner, cm, c, func(existing *corev1.ConfigMap) error {
+			if existing.Data == nil {
+				existing.Data = map[string]string{"created": value}
+			}
+
+			existing.Data["key"] = value
+
+			return nil
+		})
+	}
+
+	BeforeEach(func() {
+		r := rand.Int31() //nolint: gosec
+
+		key = types.NamespacedName{
+			Name:      fmt.Sprintf("example-%d", r),
+			Namespace: fmt.Sprintf("default-%d", r),
+		}
+		recorder = record.NewFakeRecorder(100)
+		owner = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+		}
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: key.Namespace,
+			},
+		}
+		Expect(c.Create(context.TODO(), ns)).To(Succeed())
+		Expect(c.Create(context.TODO(), owner)).To(Succeed())
+	})
+
+	It("passes the existing object to the sync function", func() {
+		typedSyncer := newSyncer("initial")
+		result, err := typedSyncer.Sync(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Operation).To(Equal(controllerutil.OperationResultCreated))
+		Expect(typedSyncer.Object().Data).To(HaveKeyWithValue("created", "initial"))
+
+		typedSyncer = newSyncer("changed")
+		result, err = typedSyncer.Sync(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Operation).To(Equal(controllerutil.OperationResultUpdated))
+		Expect(typedSyncer.Object().Data).To(Equal(map[string]string{"created": "initial", "key": "changed"}))
+		Expect(typedSyncer.Object().OwnerReferences).To(HaveLen(1))
+	})
+
+	It("can be used as an untyped syncer", func() {
+		typedSyncer := newSyncer("initial")
+		untypedSyncer := syncer.Untyped(typedSyncer)
+
+		Expect(untypedSyncer.Object()).To(BeIdenticalTo(typedSyncer.Object()))
+		Expect(syncer.Sync(context.TODO(), untypedSyncer, recorder)).To(Succeed())
+
+		var event string
+		Expect(recorder.Events).To(Receive(&event))
+		Expect(event).To(ContainSubstring("ExampleConfigMapSyncSuccessfull"))
+	})
+})